@@ -1,16 +1,30 @@
 package gom
 
-import "fmt"
+import (
+	"slices"
+	"strings"
+)
 
 type ParsersList[O any] []Parser[O]
 
+// Takes a list of parsers and returns a parser which tries each one of them in order over the same input.
+//
+// Returns the result of the first parser which succeeds. If every branch fails, returns an error which merges
+// the failures of the branches that got furthest into the input, listing everything that was expected there.
+// Fatal and [Incomplete] errors stop the evaluation and are returned as they are.
 func Alt[O any](parsers ParsersList[O]) Parser[O] {
 	return func(input string) (string, O, error) {
+		errs := make([]error, 0, len(parsers))
 
 		for _, p := range parsers {
 			next, parsed, err := p(input)
 
+			if mustPropagate(err) {
+				return "", parsed, err
+			}
+
 			if err != nil {
+				errs = append(errs, err)
 				continue
 			}
 
@@ -18,6 +32,97 @@ func Alt[O any](parsers ParsersList[O]) Parser[O] {
 		}
 
 		var parsed O
-		return "", parsed, fmt.Errorf("none branch match")
+		return "", parsed, mergeErrors(input, errs)
+	}
+}
+
+// Represents a branch of a [Dispatch] along with the bytes which its input can start with.
+//
+// First holds every byte which the parser can match at the beginning of its input. An empty First means that
+// the leading bytes of the parser are unknown, so the branch is tried for any input.
+// Expected describes what the parser expects, and is reported for the branch when it is skipped.
+type Branch[O any] struct {
+	First    string
+	Expected string
+	Parser   Parser[O]
+}
+
+// Returns the branch of a [Match] parser for the given target.
+func MatchBranch(target string) Branch[string] {
+	return Branch[string]{First: target[:min(len(target), 1)], Expected: quote(target), Parser: Match(target)}
+}
+
+// Returns the branch of a [Char] parser for the given target.
+func CharBranch(target rune) Branch[string] {
+	return Branch[string]{First: string(target)[:1], Expected: quote(string(target)), Parser: Char(target)}
+}
+
+// Returns the branch of a [OneOf] parser for the given characters.
+func OneOfBranch(characters string) Branch[string] {
+	var first strings.Builder
+
+	for _, ch := range characters {
+		first.WriteByte(string(ch)[0])
+	}
+
+	return Branch[string]{First: first.String(), Expected: "one of " + quote(characters), Parser: OneOf(characters)}
+}
+
+// Same parsing process than [Alt] but only tries the branches which can start with the first byte of the input.
+//
+// The candidate branches of every byte are computed once, keeping the order of the branches, so the branches which
+// cannot match the input are skipped without running them. If every candidate fails, the error merges their failures
+// with what the skipped branches expect, as described by their Expected field.
+func Dispatch[O any](branches ...Branch[O]) Parser[O] {
+	// The last slot holds the branches tried over an empty input.
+	var candidates [257]ParsersList[O]
+	var skipped [257][]string
+
+	for _, branch := range branches {
+		for b := 0; b < len(candidates); b++ {
+			if branch.First == "" || (b < 256 && strings.IndexByte(branch.First, byte(b)) >= 0) {
+				candidates[b] = append(candidates[b], branch.Parser)
+			} else if branch.Expected != "" && !slices.Contains(skipped[b], branch.Expected) {
+				skipped[b] = append(skipped[b], branch.Expected)
+			}
+		}
+	}
+
+	var expected [257]string
+
+	for b, descriptions := range skipped {
+		expected[b] = strings.Join(descriptions, " or ")
+	}
+
+	return func(input string) (string, O, error) {
+		slot := 256
+
+		if len(input) > 0 {
+			slot = int(input[0])
+		}
+
+		errs := make([]error, 0, len(candidates[slot])+1)
+
+		for _, p := range candidates[slot] {
+			next, parsed, err := p(input)
+
+			if mustPropagate(err) {
+				return "", parsed, err
+			}
+
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			return next, parsed, nil
+		}
+
+		if expected[slot] != "" {
+			errs = append(errs, newParseError(expected[slot], describeInput(input, 1)))
+		}
+
+		var parsed O
+		return "", parsed, mergeErrors(input, errs)
 	}
 }
@@ -1,11 +1,19 @@
 package gom
 
 import (
+	"errors"
 	"fmt"
+	"reflect"
 	"testing"
+	"unicode"
 )
 
 func TestAlt(t *testing.T) {
+	matchErr := func(expected, found string) *ParseError {
+		return &ParseError{Position: inputStart, Expected: expected, Found: found}
+	}
+	customErr := fmt.Errorf("custom failure")
+
 	tests := []ParserTestCase[ParsersList[string], string]{
 		{
 			name:  "successful parse",
@@ -31,10 +39,219 @@ func TestAlt(t *testing.T) {
 			want: ParseResult[string]{
 				next:   "",
 				parsed: "",
-				err:    fmt.Errorf("none branch match"),
+				err: &ParseError{
+					Position: inputStart,
+					Expected: `'hello' or 'dont match' or 'never match'`,
+					Found:    `'foo b'`,
+					Err: errors.Join(
+						matchErr(`'hello'`, `'foo b'`),
+						matchErr(`'dont match'`, `'foo bar ba'`),
+						matchErr(`'never match'`, `'foo bar baz'`),
+					),
+				},
+			},
+		},
+		{
+			name:  "furthest branch error",
+			input: "foo baz",
+			params: ParsersList[string]{
+				Match("bar"),
+				Preceded(Match("foo "), Match("bar")),
+				Match("baz"),
+			},
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "",
+				err: &ParseError{
+					Position: Position{Offset: 4, Line: 1, Column: 5},
+					Expected: `'bar'`,
+					Found:    `'baz'`,
+					Context:  []string{"content of Preceded"},
+					Err: &ParseError{
+						Position: Position{Offset: 4, Line: 1, Column: 5},
+						Expected: `'bar'`,
+						Found:    `'baz'`,
+						Context:  []string{"content of Preceded"},
+						Err:      matchErr(`'bar'`, `'baz'`),
+					},
+				},
+			},
+		},
+		{
+			name:  "repeated expectations error",
+			input: "abc",
+			params: ParsersList[string]{
+				Char('x'),
+				Char('x'),
+				func(input string) (string, string, error) { return "", "", customErr },
+			},
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "",
+				err: &ParseError{
+					Position: inputStart,
+					Expected: `'x'`,
+					Found:    `'a'`,
+					Err: errors.Join(
+						matchErr(`'x'`, `'a'`),
+						matchErr(`'x'`, `'a'`),
+						customErr,
+					),
+				},
+			},
+		},
+		{
+			name:   "empty branches error",
+			input:  "abc",
+			params: ParsersList[string]{},
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "",
+				err:    matchErr("any alternative", `'a'`),
 			},
 		},
 	}
 
 	ExecParserTestCases(t, Alt, tests)
 }
+
+func TestAltErrorMessage(t *testing.T) {
+	keyword := Alt(ParsersList[string]{Match("foo"), Match("bar")})
+	_, _, err := Preceded(Match("first\nsecond\nthird\n"), Preceded(Match("value "), keyword))("first\nsecond\nthird\nvalue baz")
+	want := "expected 'foo' or 'bar' but found 'baz' at line 4, column 7 in content of Preceded in content of Preceded"
+
+	if err == nil || err.Error() != want {
+		t.Fatalf("expected %q, but got %v", want, err)
+	}
+}
+
+func TestDispatch(t *testing.T) {
+	calls := 0
+	counted := func(branch Branch[string]) Branch[string] {
+		parser := branch.Parser
+		branch.Parser = func(input string) (string, string, error) {
+			calls++
+			return parser(input)
+		}
+
+		return branch
+	}
+
+	keywords := []Branch[string]{
+		counted(MatchBranch("break")),
+		counted(MatchBranch("case")),
+		counted(MatchBranch("const")),
+		counted(OneOfBranch("+-")),
+		counted(Branch[string]{Parser: StrictTakeWhile(unicode.IsDigit)}),
+	}
+
+	tests := []struct {
+		name  string
+		input string
+		want  ParseResult[string]
+		calls int
+	}{
+		{
+			name:  "successful parse",
+			input: "const x",
+			want:  ParseResult[string]{next: " x", parsed: "const", err: nil},
+			calls: 2,
+		},
+		{
+			name:  "successful parse of a branch without first bytes",
+			input: "42;",
+			want:  ParseResult[string]{next: ";", parsed: "42", err: nil},
+			calls: 1,
+		},
+		{
+			name:  "fail error",
+			input: "x",
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "",
+				err: mergeErrors("x", []error{
+					newParseError("character matching the predicate", `'x'`),
+					newParseError(`'break' or 'case' or 'const' or one of '+-'`, `'x'`),
+				}),
+			},
+			calls: 1,
+		},
+		{
+			name:  "fail error of the candidates",
+			input: "cx",
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "",
+				err: mergeErrors("cx", []error{
+					newParseError(`'case'`, `'cx'`),
+					newParseError(`'const'`, `'cx'`),
+					newParseError("character matching the predicate", `'c'`),
+					newParseError(`'break' or one of '+-'`, `'c'`),
+				}),
+			},
+			calls: 3,
+		},
+	}
+
+	parser := Dispatch(keywords...)
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			calls = 0
+			next, parsed, err := parser(tc.input)
+			got := ParseResult[string]{next, parsed, err}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("%s: expected %+v, but got %+v", tc.name, tc.want, got)
+			}
+
+			if calls != tc.calls {
+				t.Fatalf("%s: expected %d calls, but got %d", tc.name, tc.calls, calls)
+			}
+		})
+	}
+}
+
+func BenchmarkAlt(b *testing.B) {
+	keywords := ParsersList[string]{}
+
+	for _, keyword := range []string{"break", "case", "chan", "const", "continue", "default", "defer", "else"} {
+		keywords = append(keywords, Match(keyword))
+	}
+
+	ExecParserBenchmarks(b, []ParserBenchmark{
+		NewParserBenchmark("first branch", "break;", Alt(keywords)),
+		NewParserBenchmark("last branch", "else;", Alt(keywords)),
+	})
+}
+
+func BenchmarkDispatch(b *testing.B) {
+	keywords := []Branch[string]{}
+
+	for _, keyword := range []string{"break", "case", "chan", "const", "continue", "default", "defer", "else"} {
+		keywords = append(keywords, MatchBranch(keyword))
+	}
+
+	ExecParserBenchmarks(b, []ParserBenchmark{
+		NewParserBenchmark("first branch", "break;", Dispatch(keywords...)),
+		NewParserBenchmark("last branch", "else;", Dispatch(keywords...)),
+	})
+}
+
+func BenchmarkDispatchMiss(b *testing.B) {
+	keywords := ParsersList[string]{}
+	branches := []Branch[string]{}
+
+	for _, keyword := range []string{
+		"break", "case", "chan", "const", "continue", "default", "defer", "else", "fallthrough", "for", "func", "go", "goto",
+		"if", "import", "interface", "map", "package", "range", "return", "select", "struct", "switch", "type", "var",
+	} {
+		keywords = append(keywords, Match(keyword))
+		branches = append(branches, MatchBranch(keyword))
+	}
+
+	ExecParserBenchmarks(b, []ParserBenchmark{
+		NewFailingParserBenchmark("alt", "cx", Alt(keywords)),
+		NewFailingParserBenchmark("dispatch", "cx", Dispatch(branches...)),
+	})
+}
@@ -0,0 +1,191 @@
+package gom
+
+import "errors"
+
+// Takes a name and a parser, and returns a parser which attaches the name to the context stack of the errors returned by the given parser.
+//
+// Useful for naming grammar rules, so errors read like "expected ')' but found ';' at line 3, column 7 in function declaration".
+func Context[O any](name string, parser Parser[O]) Parser[O] {
+	return func(input string) (string, O, error) {
+		next, parsed, err := parser(input)
+
+		if err != nil {
+			return "", parsed, wrapError(err, "", name)
+		}
+
+		return next, parsed, nil
+	}
+}
+
+// Takes a name and a parser, and returns a parser which reports the given name as the expected token when the parser fails.
+//
+// The expectation is only replaced when the parser fails without consuming any input, so failures deep inside
+// a partially matched rule keep pointing to the exact token which was expected there.
+func Label[O any](name string, parser Parser[O]) Parser[O] {
+	return func(input string) (string, O, error) {
+		next, parsed, err := parser(input)
+
+		if err == nil {
+			return next, parsed, nil
+		}
+
+		var parseErr *ParseError
+
+		if errors.As(err, &parseErr) && parseErr.Offset > 0 {
+			return "", parsed, err
+		}
+
+		labeled := newParseError(name, describeInput(input, 1))
+		labeled.Err = err
+		labeled.Fatal = IsFatal(err)
+
+		return "", parsed, labeled
+	}
+}
+
+// Takes a parser and returns a parser which turns its errors into fatal errors.
+//
+// Once a grammar has committed to a branch, for example after reading a keyword, cutting the rest of the branch makes
+// [Alt], [Many] and [StrictMany] report its failures instead of backtracking and trying something else.
+func Cut[O any](parser Parser[O]) Parser[O] {
+	return func(input string) (string, O, error) {
+		next, parsed, err := parser(input)
+
+		if err != nil {
+			cut := wrapError(err, "", "")
+			cut.Fatal = true
+
+			return "", parsed, cut
+		}
+
+		return next, parsed, nil
+	}
+}
+
+// Takes a parser and a mapper function, and returns a parser which transforms the output of the given parser with the mapper.
+//
+// Errors of the given parser are returned untouched.
+func Map[T, O any](parser Parser[T], mapper func(parsed T) O) Parser[O] {
+	return Parser[O](InputMap(InputParser[string, T](parser), mapper))
+}
+
+// Takes a parser and a fallible mapper function, and returns a parser which transforms the output of the given parser with the mapper.
+//
+// When the mapper fails, returns a parse error located where the parsed value starts, which wraps the mapper error.
+func TryMap[T, O any](parser Parser[T], mapper func(parsed T) (O, error)) Parser[O] {
+	return func(input string) (string, O, error) {
+		next, parsed, err := parser(input)
+
+		if err != nil {
+			var mapped O
+			return "", mapped, err
+		}
+
+		mapped, err := mapper(parsed)
+
+		if err != nil {
+			var mapped O
+			return "", mapped, wrapError(err, "", "")
+		}
+
+		return next, mapped, nil
+	}
+}
+
+// Takes a parser and an error mapper function, and returns a parser which rewrites the errors of the given parser with the mapper.
+func MapErr[O any](parser Parser[O], mapper func(err error) error) Parser[O] {
+	return func(input string) (string, O, error) {
+		next, parsed, err := parser(input)
+
+		if err != nil {
+			return "", parsed, mapper(err)
+		}
+
+		return next, parsed, nil
+	}
+}
+
+// Takes a parser and returns a parser which makes it optional.
+//
+// If the parser succeeds, returns its rest of the input and a pointer to its output. Else returns the whole input,
+// a nil pointer and a nil error, without consuming anything. Fatal and [Incomplete] errors are still returned.
+func Opt[O any](parser Parser[O]) Parser[*O] {
+	return Parser[*O](InputOpt(InputParser[string, O](parser)))
+}
+
+// Takes a value and a parser, and returns a parser which replaces the output of the given parser with the value.
+func Value[T, O any](value O, parser Parser[T]) Parser[O] {
+	return Map(parser, func(T) O {
+		return value
+	})
+}
+
+// Takes a parser and returns a parser which succeeds only when the given parser fails, without consuming any input.
+//
+// If the given parser succeeds, returns a fullfilled error describing the matched text. Fatal and [Incomplete] errors are still returned.
+func Not[O any](parser Parser[O]) Parser[struct{}] {
+	return func(input string) (string, struct{}, error) {
+		next, _, err := parser(input)
+
+		if mustPropagate(err) {
+			return "", struct{}{}, err
+		}
+
+		if err != nil {
+			return input, struct{}{}, nil
+		}
+
+		found := describeInput(input, 1)
+
+		if matched := consumedText(input, next); matched != "" {
+			found = quote(matched)
+		}
+
+		return "", struct{}{}, newParseError("something else", found)
+	}
+}
+
+// Takes a parser and returns a parser which applies it without consuming any input.
+//
+// If the parser succeeds, returns the whole input, the parser output and a nil error.
+func Peek[O any](parser Parser[O]) Parser[O] {
+	return func(input string) (string, O, error) {
+		_, parsed, err := parser(input)
+
+		if err != nil {
+			return "", parsed, err
+		}
+
+		return input, parsed, nil
+	}
+}
+
+// Takes a parser and returns a parser which outputs the slice of the input consumed by the given parser, instead of its output.
+func Recognize[O any](parser Parser[O]) Parser[string] {
+	return func(input string) (string, string, error) {
+		next, _, err := parser(input)
+
+		if err != nil {
+			return "", "", err
+		}
+
+		return next, consumedText(input, next), nil
+	}
+}
+
+// Takes a parser and returns a parser which outputs both the slice of the input consumed by the given parser and its output.
+func Consumed[O any](parser Parser[O]) Parser[PairResult[string, O]] {
+	return func(input string) (string, PairResult[string, O], error) {
+		var result PairResult[string, O]
+		next, parsed, err := parser(input)
+
+		if err != nil {
+			return "", result, err
+		}
+
+		result.First = consumedText(input, next)
+		result.Second = parsed
+
+		return next, result, nil
+	}
+}
@@ -0,0 +1,588 @@
+package gom
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+	"unicode"
+)
+
+type NamedParserParams[O any] struct {
+	name   string
+	parser Parser[O]
+}
+
+type NamedParserTestCase[O any] struct {
+	name   string
+	input  string
+	params NamedParserParams[O]
+	want   ParseResult[O]
+}
+
+func TestContext(t *testing.T) {
+	tests := []NamedParserTestCase[string]{
+		{
+			name:  "successful parse",
+			input: "func main",
+			params: NamedParserParams[string]{
+				name:   "function declaration",
+				parser: Match("func"),
+			},
+			want: ParseResult[string]{
+				next:   " main",
+				parsed: "func",
+				err:    nil,
+			},
+		},
+		{
+			name:  "context added error",
+			input: "func 1",
+			params: NamedParserParams[string]{
+				name:   "function declaration",
+				parser: Preceded(Match("func "), StrictTakeWhile(unicode.IsLetter)),
+			},
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "",
+				err: &ParseError{
+					Position: Position{Offset: 5, Line: 1, Column: 6},
+					Expected: "character matching the predicate",
+					Found:    `'1'`,
+					Context:  []string{"content of Preceded", "function declaration"},
+					Err: &ParseError{
+						Position: Position{Offset: 5, Line: 1, Column: 6},
+						Expected: "character matching the predicate",
+						Found:    `'1'`,
+						Context:  []string{"content of Preceded"},
+						Err: &ParseError{
+							Position: inputStart,
+							Expected: "character matching the predicate",
+							Found:    `'1'`,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			next, parsed, err := Context(tc.params.name, tc.params.parser)(tc.input)
+			got := ParseResult[string]{next, parsed, err}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("%s: expected %+v, but got %+v", tc.name, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestLabel(t *testing.T) {
+	innerErr := &ParseError{
+		Position: inputStart,
+		Expected: "character matching the predicate",
+		Found:    `'1'`,
+	}
+	consumingErr := &ParseError{
+		Position: Position{Offset: 1, Line: 1, Column: 2},
+		Expected: `'b'`,
+		Found:    `'x'`,
+		Context:  []string{"second parser of Pair"},
+		Err: &ParseError{
+			Position: inputStart,
+			Expected: `'b'`,
+			Found:    `'x'`,
+		},
+	}
+
+	tests := []NamedParserTestCase[string]{
+		{
+			name:  "successful parse",
+			input: "name = 1",
+			params: NamedParserParams[string]{
+				name:   "identifier",
+				parser: StrictTakeWhile(unicode.IsLetter),
+			},
+			want: ParseResult[string]{
+				next:   " = 1",
+				parsed: "name",
+				err:    nil,
+			},
+		},
+		{
+			name:  "expectation replaced error",
+			input: "1 = name",
+			params: NamedParserParams[string]{
+				name:   "identifier",
+				parser: StrictTakeWhile(unicode.IsLetter),
+			},
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "",
+				err: &ParseError{
+					Position: inputStart,
+					Expected: "identifier",
+					Found:    `'1'`,
+					Err:      innerErr,
+				},
+			},
+		},
+		{
+			name:  "input consumed error",
+			input: "ax",
+			params: NamedParserParams[string]{
+				name: "ab pair",
+				parser: func(input string) (string, string, error) {
+					_, _, err := Pair(Char('a'), Char('b'))(input)
+					return "", "", err
+				},
+			},
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "",
+				err:    consumingErr,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			next, parsed, err := Label(tc.params.name, tc.params.parser)(tc.input)
+			got := ParseResult[string]{next, parsed, err}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("%s: expected %+v, but got %+v", tc.name, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestLabelAndContextMessage(t *testing.T) {
+	identifier := Label("identifier", StrictTakeWhile(unicode.IsLetter))
+	declaration := Context("function declaration", Preceded(Match("func "), identifier))
+	_, _, err := declaration("func 123")
+	want := "expected identifier but found '1' at line 1, column 6 in content of Preceded in function declaration"
+
+	if err == nil || err.Error() != want {
+		t.Fatalf("expected %q, but got %v", want, err)
+	}
+}
+
+func TestCut(t *testing.T) {
+	tests := []ParserTestCase[Parser[string], string]{
+		{
+			name:   "successful parse",
+			input:  "abc",
+			params: Char('a'),
+			want: ParseResult[string]{
+				next:   "bc",
+				parsed: "a",
+				err:    nil,
+			},
+		},
+		{
+			name:   "fatal error",
+			input:  "abc",
+			params: Preceded(Char('a'), Char('c')),
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "",
+				err: &ParseError{
+					Position: Position{Offset: 1, Line: 1, Column: 2},
+					Expected: `'c'`,
+					Found:    `'b'`,
+					Context:  []string{"content of Preceded"},
+					Fatal:    true,
+					Err: &ParseError{
+						Position: Position{Offset: 1, Line: 1, Column: 2},
+						Expected: `'c'`,
+						Found:    `'b'`,
+						Context:  []string{"content of Preceded"},
+						Err: &ParseError{
+							Position: inputStart,
+							Expected: `'c'`,
+							Found:    `'b'`,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	ExecParserTestCases(t, Cut, tests)
+}
+
+func TestCutStopsBacktracking(t *testing.T) {
+	statement := Alt(ParsersList[string]{
+		Preceded(Match("func "), Cut(StrictTakeWhile(unicode.IsLetter))),
+		TakeWhile(unicode.IsLetter),
+	})
+
+	tests := []struct {
+		name   string
+		parser Parser[[]string]
+		input  string
+		want   string
+	}{
+		{
+			name:   "Alt propagates fatal errors",
+			parser: Count(statement, 1),
+			input:  "func 123",
+			want:   "expected character matching the predicate but found '1' at line 1, column 6 in content of Preceded in item 1 of Count",
+		},
+		{
+			name:   "Many propagates fatal errors",
+			parser: Many(Terminated(statement, Char(';'))),
+			input:  "func foo;func 1;",
+			want:   "expected character matching the predicate but found '1' at line 1, column 15 in content of Preceded in content of Terminated in item 2 of Many",
+		},
+		{
+			name:   "StrictMany propagates fatal errors",
+			parser: StrictMany(Terminated(statement, Char(';'))),
+			input:  "func ;",
+			want:   "expected character matching the predicate but found ';' at line 1, column 6 in content of Preceded in content of Terminated in item 1 of StrictMany",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := tc.parser(tc.input)
+
+			if !IsFatal(err) || err.Error() != tc.want {
+				t.Fatalf("%s: expected fatal error %q, but got %v", tc.name, tc.want, err)
+			}
+		})
+	}
+}
+
+func TestMap(t *testing.T) {
+	tests := []ParserTestCase[Parser[string], int]{
+		{
+			name:   "successful parse",
+			input:  "hello world",
+			params: StrictTakeWhile(unicode.IsLetter),
+			want: ParseResult[int]{
+				next:   " world",
+				parsed: 5,
+				err:    nil,
+			},
+		},
+		{
+			name:   "parser fail error",
+			input:  "123",
+			params: StrictTakeWhile(unicode.IsLetter),
+			want: ParseResult[int]{
+				next:   "",
+				parsed: 0,
+				err: &ParseError{
+					Position: inputStart,
+					Expected: "character matching the predicate",
+					Found:    `'1'`,
+				},
+			},
+		},
+	}
+
+	length := func(parser Parser[string]) Parser[int] {
+		return Map(parser, func(parsed string) int { return len(parsed) })
+	}
+
+	ExecParserTestCases(t, length, tests)
+}
+
+func TestTryMap(t *testing.T) {
+	_, rangeErr := strconv.Atoi("99999999999999999999")
+
+	tests := []ParserTestCase[string, int]{
+		{
+			name:   "successful parse",
+			input:  "x = 42;",
+			params: "x",
+			want: ParseResult[int]{
+				next:   ";",
+				parsed: 42,
+				err:    nil,
+			},
+		},
+		{
+			name:   "mapper fail error",
+			input:  "x = 99999999999999999999;",
+			params: "x",
+			want: ParseResult[int]{
+				next:   "",
+				parsed: 0,
+				err: &ParseError{
+					Position: Position{Offset: 4, Line: 1, Column: 5},
+					Context:  []string{"content of Preceded"},
+					Err: &ParseError{
+						Position: inputStart,
+						Err:      rangeErr,
+					},
+				},
+			},
+		},
+	}
+
+	assignment := func(name string) Parser[int] {
+		return Preceded(Match(name+" = "), TryMap(TakeWhile(unicode.IsDigit), strconv.Atoi))
+	}
+
+	ExecParserTestCases(t, assignment, tests)
+}
+
+func TestMapErr(t *testing.T) {
+	errKeyword := fmt.Errorf("missing keyword")
+
+	tests := []ParserTestCase[string, string]{
+		{
+			name:   "successful parse",
+			input:  "func main",
+			params: "func",
+			want: ParseResult[string]{
+				next:   " main",
+				parsed: "func",
+				err:    nil,
+			},
+		},
+		{
+			name:   "error rewritten",
+			input:  "var x",
+			params: "func",
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "",
+				err:    errKeyword,
+			},
+		},
+	}
+
+	keyword := func(target string) Parser[string] {
+		return MapErr(Match(target), func(err error) error { return errKeyword })
+	}
+
+	ExecParserTestCases(t, keyword, tests)
+}
+
+func TestOpt(t *testing.T) {
+	minus := "-"
+
+	tests := []ParserTestCase[Parser[string], *string]{
+		{
+			name:   "successful parse",
+			input:  "-42",
+			params: Char('-'),
+			want: ParseResult[*string]{
+				next:   "42",
+				parsed: &minus,
+				err:    nil,
+			},
+		},
+		{
+			name:   "successful empty parse",
+			input:  "42",
+			params: Char('-'),
+			want: ParseResult[*string]{
+				next:   "42",
+				parsed: nil,
+				err:    nil,
+			},
+		},
+		{
+			name:   "fatal error",
+			input:  "42",
+			params: Cut(Char('-')),
+			want: ParseResult[*string]{
+				next:   "",
+				parsed: nil,
+				err: &ParseError{
+					Position: inputStart,
+					Expected: `'-'`,
+					Found:    `'4'`,
+					Fatal:    true,
+					Err: &ParseError{
+						Position: inputStart,
+						Expected: `'-'`,
+						Found:    `'4'`,
+					},
+				},
+			},
+		},
+	}
+
+	ExecParserTestCases(t, Opt, tests)
+}
+
+func TestValue(t *testing.T) {
+	tests := []ParserTestCase[string, bool]{
+		{
+			name:   "successful parse",
+			input:  "true,",
+			params: "true",
+			want: ParseResult[bool]{
+				next:   ",",
+				parsed: true,
+				err:    nil,
+			},
+		},
+		{
+			name:   "parser fail error",
+			input:  "false,",
+			params: "true",
+			want: ParseResult[bool]{
+				next:   "",
+				parsed: false,
+				err: &ParseError{
+					Position: inputStart,
+					Expected: `'true'`,
+					Found:    `'fals'`,
+				},
+			},
+		},
+	}
+
+	boolean := func(target string) Parser[bool] {
+		return Value(true, Match(target))
+	}
+
+	ExecParserTestCases(t, boolean, tests)
+}
+
+func TestNot(t *testing.T) {
+	tests := []ParserTestCase[Parser[string], struct{}]{
+		{
+			name:   "successful parse",
+			input:  "identifier",
+			params: Match("if"),
+			want: ParseResult[struct{}]{
+				next:   "identifier",
+				parsed: struct{}{},
+				err:    nil,
+			},
+		},
+		{
+			name:   "parser match error",
+			input:  "if x",
+			params: Match("if"),
+			want: ParseResult[struct{}]{
+				next:   "",
+				parsed: struct{}{},
+				err: &ParseError{
+					Position: inputStart,
+					Expected: "something else",
+					Found:    `'if'`,
+				},
+			},
+		},
+		{
+			name:   "parser match without consuming error",
+			input:  "x",
+			params: TakeWhile(unicode.IsDigit),
+			want: ParseResult[struct{}]{
+				next:   "",
+				parsed: struct{}{},
+				err: &ParseError{
+					Position: inputStart,
+					Expected: "something else",
+					Found:    `'x'`,
+				},
+			},
+		},
+	}
+
+	ExecParserTestCases(t, Not, tests)
+}
+
+func TestPeek(t *testing.T) {
+	tests := []ParserTestCase[Parser[string], string]{
+		{
+			name:   "successful parse",
+			input:  "func main",
+			params: Match("func"),
+			want: ParseResult[string]{
+				next:   "func main",
+				parsed: "func",
+				err:    nil,
+			},
+		},
+		{
+			name:   "parser fail error",
+			input:  "var x",
+			params: Match("func"),
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "",
+				err: &ParseError{
+					Position: inputStart,
+					Expected: `'func'`,
+					Found:    `'var '`,
+				},
+			},
+		},
+	}
+
+	ExecParserTestCases(t, Peek, tests)
+}
+
+func TestRecognize(t *testing.T) {
+	tests := []ParserTestCase[Parser[PairResult[string, string]], string]{
+		{
+			name:   "successful parse",
+			input:  "key=value;",
+			params: Pair(Terminated(TakeWhile(unicode.IsLetter), Char('=')), TakeWhile(unicode.IsLetter)),
+			want: ParseResult[string]{
+				next:   ";",
+				parsed: "key=value",
+				err:    nil,
+			},
+		},
+		{
+			name:   "parser fail error",
+			input:  "key:value;",
+			params: Pair(Terminated(TakeWhile(unicode.IsLetter), Char('=')), TakeWhile(unicode.IsLetter)),
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "",
+				err: &ParseError{
+					Position: Position{Offset: 3, Line: 1, Column: 4},
+					Expected: `'='`,
+					Found:    `':'`,
+					Context:  []string{"terminator of Terminated", "first parser of Pair"},
+					Err: &ParseError{
+						Position: Position{Offset: 3, Line: 1, Column: 4},
+						Expected: `'='`,
+						Found:    `':'`,
+						Context:  []string{"terminator of Terminated"},
+						Err: &ParseError{
+							Position: inputStart,
+							Expected: `'='`,
+							Found:    `':'`,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	ExecParserTestCases(t, Recognize, tests)
+}
+
+func TestConsumed(t *testing.T) {
+	tests := []ParserTestCase[Parser[[]string], PairResult[string, []string]]{
+		{
+			name:   "successful parse",
+			input:  "a,b,c,;",
+			params: Many(Terminated(StrictTakeWhile(unicode.IsLetter), Char(','))),
+			want: ParseResult[PairResult[string, []string]]{
+				next: ";",
+				parsed: PairResult[string, []string]{
+					First:  "a,b,c,",
+					Second: []string{"a", "b", "c"},
+				},
+				err: nil,
+			},
+		},
+	}
+
+	ExecParserTestCases(t, Consumed, tests)
+}
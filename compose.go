@@ -0,0 +1,225 @@
+package gom
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// Returns the position reached after consuming the first elements of a slice input.
+//
+// Byte and rune slices are text, so their lines and columns are tracked like the ones of strings, while Offset counts
+// elements. Other slices are token slices, whose positions are token indexes.
+func advanceSlice[S ~[]E, E any](input S, consumed int) Position {
+	var element E
+
+	switch any(element).(type) {
+	case byte:
+		// The bytes are only read while advancing, so they are viewed as a string without copying them.
+		return inputStart.advance(unsafe.String((*byte)(unsafe.Pointer(unsafe.SliceData(input))), consumed))
+	case rune:
+		position := Position{Offset: consumed, Line: 1, Column: 1}
+
+		for _, ch := range unsafe.Slice((*rune)(unsafe.Pointer(unsafe.SliceData(input))), consumed) {
+			if ch == '\n' {
+				position.Line++
+				position.Column = 1
+			} else {
+				position.Column++
+			}
+		}
+
+		return position
+	default:
+		return Position{Offset: consumed, Line: 1, Column: consumed + 1}
+	}
+}
+
+// Same wrapping process than [wrapError] but for an inner parser which received the rest of a slice input.
+func wrapSliceError[S ~[]E, E any](err error, input, next S, context string) *ParseError {
+	return wrapErrorAt(err, advanceSlice(input, len(input)-len(next)), context)
+}
+
+// Same parsing process than [Pair] but over slice inputs: byte slices, rune slices and token slices.
+//
+// Combinators over strings only accept [Parser], so the Input family composes the [InputParser] built with the
+// Text and Token primitives into grammars over other inputs.
+func InputPair[S ~[]E, E, T, K any](firstParser InputParser[S, T], secondParser InputParser[S, K]) InputParser[S, PairResult[T, K]] {
+	return func(input S) (S, PairResult[T, K], error) {
+		var result PairResult[T, K]
+		rest, p1, err := firstParser(input)
+
+		if err != nil {
+			return nil, result, wrapSliceError(err, input, input, "first parser of Pair")
+		}
+
+		next, p2, err := secondParser(rest)
+
+		if err != nil {
+			return nil, result, wrapSliceError(err, input, rest, "second parser of Pair")
+		}
+
+		result.First = p1
+		result.Second = p2
+
+		return next, result, nil
+	}
+}
+
+// Same parsing process than [Delimited] but over slice inputs.
+func InputDelimited[S ~[]E, E, T, K, O any](opener InputParser[S, T], parser InputParser[S, O], closer InputParser[S, K]) InputParser[S, O] {
+	return func(input S) (S, O, error) {
+		var empty O
+		next, _, err := opener(input)
+
+		if err != nil {
+			return nil, empty, wrapSliceError(err, input, input, "opener of Delimited")
+		}
+
+		rest, parsed, err := parser(next)
+
+		if err != nil {
+			return nil, empty, wrapSliceError(err, input, next, "content of Delimited")
+		}
+
+		next, _, err = closer(rest)
+
+		if err != nil {
+			return nil, empty, wrapSliceError(err, input, rest, "closer of Delimited")
+		}
+
+		return next, parsed, nil
+	}
+}
+
+// Same parsing process than [Preceded] but over slice inputs.
+func InputPreceded[S ~[]E, E, T, O any](preceded InputParser[S, T], parser InputParser[S, O]) InputParser[S, O] {
+	return func(input S) (S, O, error) {
+		var empty O
+		next, _, err := preceded(input)
+
+		if err != nil {
+			return nil, empty, wrapSliceError(err, input, input, "prefix of Preceded")
+		}
+
+		rest, parsed, err := parser(next)
+
+		if err != nil {
+			return nil, empty, wrapSliceError(err, input, next, "content of Preceded")
+		}
+
+		return rest, parsed, nil
+	}
+}
+
+// Same parsing process than [Terminated] but over slice inputs.
+func InputTerminated[S ~[]E, E, T, O any](parser InputParser[S, O], terminated InputParser[S, T]) InputParser[S, O] {
+	return func(input S) (S, O, error) {
+		var empty O
+		next, parsed, err := parser(input)
+
+		if err != nil {
+			return nil, empty, wrapSliceError(err, input, input, "content of Terminated")
+		}
+
+		rest, _, err := terminated(next)
+
+		if err != nil {
+			return nil, empty, wrapSliceError(err, input, next, "terminator of Terminated")
+		}
+
+		return rest, parsed, nil
+	}
+}
+
+// Same parsing process than [Alt] but over slice inputs.
+func InputAlt[S ~[]E, E, O any](parsers ...InputParser[S, O]) InputParser[S, O] {
+	return func(input S) (S, O, error) {
+		var empty O
+
+		errs := make([]error, 0, len(parsers))
+
+		for _, p := range parsers {
+			next, parsed, err := p(input)
+
+			if mustPropagate(err) {
+				return nil, empty, err
+			}
+
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+
+			return next, parsed, nil
+		}
+
+		if len(errs) == 0 {
+			return nil, empty, newParseError("any alternative", describeToken(input))
+		}
+
+		return nil, empty, mergeErrors("", errs)
+	}
+}
+
+// Same parsing process than [Many] but over slice inputs.
+func InputMany[S ~[]E, E, O any](parser InputParser[S, O]) InputParser[S, []O] {
+	return func(input S) (S, []O, error) {
+		accumulated := []O{}
+		next := input
+
+		for len(next) > 0 {
+			n, p, err := parser(next)
+			context := fmt.Sprintf("item %d of Many", len(accumulated)+1)
+
+			if mustPropagate(err) {
+				return nil, []O{}, wrapSliceError(err, input, next, context)
+			}
+
+			if err != nil {
+				break
+			}
+
+			if len(n) == len(next) {
+				return nil, []O{}, wrapSliceError(ErrNoProgress, input, next, context)
+			}
+
+			accumulated = append(accumulated, p)
+			next = n
+		}
+
+		return next, accumulated, nil
+	}
+}
+
+// Same parsing process than [Map] but over any input.
+func InputMap[I, T, O any](parser InputParser[I, T], mapper func(parsed T) O) InputParser[I, O] {
+	return func(input I) (I, O, error) {
+		next, parsed, err := parser(input)
+
+		if err != nil {
+			var empty I
+			var mapped O
+			return empty, mapped, err
+		}
+
+		return next, mapper(parsed), nil
+	}
+}
+
+// Same parsing process than [Opt] but over any input.
+func InputOpt[I, O any](parser InputParser[I, O]) InputParser[I, *O] {
+	return func(input I) (I, *O, error) {
+		next, parsed, err := parser(input)
+
+		if mustPropagate(err) {
+			var empty I
+			return empty, nil, err
+		}
+
+		if err != nil {
+			return input, nil, nil
+		}
+
+		return next, &parsed, nil
+	}
+}
@@ -0,0 +1,107 @@
+package gom
+
+import (
+	"errors"
+	"testing"
+	"unicode"
+)
+
+func TestInputCombinatorsOverTokens(t *testing.T) {
+	// let x = 1;
+	assignment := InputTerminated(
+		InputPreceded(
+			Token[testTokens](letToken),
+			InputPair(Token[testTokens](nameToken), InputPreceded(Token[testTokens](equalsToken), Token[testTokens](numberToken))),
+		),
+		Token[testTokens](semiToken),
+	)
+	statements := InputMany(InputAlt(
+		InputMap(assignment, func(parsed PairResult[testToken, testToken]) string {
+			return parsed.First.value + "=" + parsed.Second.value
+		}),
+		InputMap(Token[testTokens](semiToken), func(testToken) string { return "empty" }),
+	))
+
+	tests := []InputParserTestCase[testTokens, string, []string]{
+		{
+			name:  "successful parse",
+			input: testTokens{letToken, nameToken, equalsToken, numberToken, semiToken, semiToken, nameToken},
+			want: InputParseResult[testTokens, []string]{
+				next:   testTokens{nameToken},
+				parsed: []string{"x=1", "empty"},
+				err:    nil,
+			},
+		},
+	}
+
+	ExecInputParserTestCases(t, func(string) InputParser[testTokens, []string] { return statements }, tests)
+
+	t.Run("fail error", func(t *testing.T) {
+		_, _, err := assignment(testTokens{letToken, nameToken, semiToken})
+		want := "expected {operator =} but found {punctuation ;} at line 1, column 3" +
+			" in prefix of Preceded in second parser of Pair in content of Preceded in content of Terminated"
+
+		if err == nil || err.Error() != want {
+			t.Fatalf("expected %q, but got %v", want, err)
+		}
+	})
+}
+
+func TestInputCombinatorsOverText(t *testing.T) {
+	word := TextStrictTakeWhile[[]byte](unicode.IsLetter)
+	line := InputTerminated(InputPair(word, InputPreceded(TextChar[[]byte](' '), word)), TextChar[[]byte]('\n'))
+
+	tests := []InputParserTestCase[[]byte, string, []PairResult[[]byte, []byte]]{
+		{
+			name:  "successful parse",
+			input: []byte("hello world\nbye now\n"),
+			want: InputParseResult[[]byte, []PairResult[[]byte, []byte]]{
+				next: []byte{},
+				parsed: []PairResult[[]byte, []byte]{
+					{First: []byte("hello"), Second: []byte("world")},
+					{First: []byte("bye"), Second: []byte("now")},
+				},
+				err: nil,
+			},
+		},
+	}
+
+	ExecInputParserTestCases(t, func(string) InputParser[[]byte, []PairResult[[]byte, []byte]] { return InputMany(line) }, tests)
+
+	t.Run("fail error on second line", func(t *testing.T) {
+		_, _, err := InputPair(line, line)([]byte("hello world\nbye 42\n"))
+		want := "expected character matching the predicate but found '4' at line 2, column 5" +
+			" in content of Preceded in second parser of Pair in content of Terminated in second parser of Pair"
+
+		if err == nil || err.Error() != want {
+			t.Fatalf("expected %q, but got %v", want, err)
+		}
+	})
+
+	t.Run("fail error after multi-byte characters", func(t *testing.T) {
+		_, _, err := InputPair(TextMatch([]byte("éé")), TextChar[[]byte]('x'))([]byte("ééy"))
+		want := Position{Offset: 4, Line: 1, Column: 3}
+
+		var parseErr *ParseError
+
+		if !errors.As(err, &parseErr) || parseErr.Position != want {
+			t.Fatalf("expected an error at %+v, but got %v", want, err)
+		}
+	})
+}
+
+func TestInputCombinatorsOverRunes(t *testing.T) {
+	greeting := InputPair(TokenMatch([]rune("¡hola")), InputOpt(Token[[]rune]('!')))
+	next, parsed, err := greeting([]rune("¡hola! señor"))
+
+	if err != nil || string(next) != " señor" || string(parsed.First) != "¡hola" || parsed.Second == nil || *parsed.Second != '!' {
+		t.Fatalf("expected to parse ¡hola!, but got %q, %+v and %v", string(next), parsed, err)
+	}
+
+	_, _, err = greeting([]rune("¡hol"))
+	want := "expected 'a' but found end of input at line 1, column 5 in first parser of Pair"
+
+	if err == nil || err.Error() != want {
+		t.Fatalf("expected %q, but got %v", want, err)
+	}
+}
@@ -0,0 +1,247 @@
+package gom
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// Represents a location inside an input string.
+//
+// Offset is measured in bytes from the beginning of the input. Line and Column are 1-based, and Column is measured in runes.
+type Position struct {
+	Offset int
+	Line   int
+	Column int
+}
+
+// Description used in parse errors when there is nothing left to parse.
+const endOfInput = "end of input"
+
+// Maximum amount of runes quoted by parse errors which describe an input too short to match, so large inputs do not bloat them.
+const maxFoundRunes = 8
+
+// Reported by repetition combinators when their inner parser succeeds without consuming input, which would repeat it forever.
+var ErrNoProgress = errors.New("parser succeeded without consuming input")
+
+// Reported by [ManyMN] when its minimum amount of matches is greater than its maximum, so no amount of matches satisfies both.
+var ErrInvalidBounds = errors.New("minimum amount of matches greater than maximum")
+
+// Position of the first character of any input string.
+var inputStart = Position{Offset: 0, Line: 1, Column: 1}
+
+// Returns the position reached after consuming the given text starting from the current position.
+func (p Position) advance(consumed string) Position {
+	p.Offset += len(consumed)
+
+	if i := strings.LastIndexByte(consumed, '\n'); i >= 0 {
+		p.Line += strings.Count(consumed, "\n")
+		p.Column = 1 + utf8.RuneCountInString(consumed[i+1:])
+	} else {
+		p.Column += utf8.RuneCountInString(consumed)
+	}
+
+	return p
+}
+
+// Translates a position relative to a nested input into the coordinates of the enclosing input, given that the nested input starts at the current position.
+func (p Position) join(relative Position) Position {
+	joined := Position{
+		Offset: p.Offset + relative.Offset,
+		Line:   p.Line + relative.Line - 1,
+		Column: relative.Column,
+	}
+
+	if relative.Line == 1 {
+		joined.Column = p.Column + relative.Column - 1
+	}
+
+	return joined
+}
+
+// Describes a parser failure: where it happened, what was expected and what was found instead.
+//
+// The position is relative to the input received by the outermost parser which returned the error,
+// so errors returned by combinators point to the exact place of the failure inside their own input.
+//
+// Combinators wrap the errors of their inner parsers, so the original cause is available through [errors.Unwrap],
+// while Context holds the path of combinators which led to the failure, from the innermost to the outermost one.
+//
+// Fatal errors are the ones returned after a [Cut]. Combinators which backtrack, like [Alt] and [Many], propagate them
+// instead of trying other branches.
+type ParseError struct {
+	Position
+	Expected string
+	Found    string
+	Context  []string
+	Err      error
+	Fatal    bool
+}
+
+func (e *ParseError) Error() string {
+	message := e.describe() + fmt.Sprintf(" at line %d, column %d", e.Line, e.Column)
+
+	for _, context := range e.Context {
+		message += " in " + context
+	}
+
+	return message
+}
+
+// Describes the failure without its location, falling back to the innermost cause when nothing was expected.
+func (e *ParseError) describe() string {
+	var parseErr *ParseError
+
+	switch {
+	case e.Expected != "":
+		return fmt.Sprintf("expected %s but found %s", e.Expected, e.Found)
+	case errors.As(e.Err, &parseErr):
+		return parseErr.describe()
+	case e.Err != nil:
+		return e.Err.Error()
+	default:
+		return "parse error"
+	}
+}
+
+// Returns the error reported by the inner parser, if any.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Reports whether the error is a fatal parse error, which must not be recovered by backtracking.
+func IsFatal(err error) bool {
+	var parseErr *ParseError
+
+	return errors.As(err, &parseErr) && parseErr.Fatal
+}
+
+// Reports whether the error must be returned as it is instead of being backtracked over, as fatal and [Incomplete] errors are.
+func mustPropagate(err error) bool {
+	return IsFatal(err) || IsIncomplete(err)
+}
+
+// Builds a parse error located at the beginning of the input.
+func newParseError(expected, found string) *ParseError {
+	return &ParseError{
+		Position: inputStart,
+		Expected: expected,
+		Found:    found,
+	}
+}
+
+// Wraps the error returned by an inner parser which received the input left after consuming the given text.
+//
+// The resulting error is relocated to the coordinates of the outer input and the given context, if any, is pushed on top of the context stack.
+func wrapError(err error, consumed string, context string) *ParseError {
+	return wrapErrorAt(err, inputStart.advance(consumed), context)
+}
+
+// Same wrapping process than [wrapError] but for an inner parser whose input starts at the given position of the outer input.
+func wrapErrorAt(err error, start Position, context string) *ParseError {
+	wrapped := &ParseError{
+		Position: start,
+		Err:      err,
+	}
+
+	var parseErr *ParseError
+
+	if errors.As(err, &parseErr) {
+		wrapped.Position = wrapped.Position.join(parseErr.Position)
+		wrapped.Expected = parseErr.Expected
+		wrapped.Found = parseErr.Found
+		wrapped.Context = slices.Clone(parseErr.Context)
+		wrapped.Fatal = parseErr.Fatal
+	}
+
+	if context != "" {
+		wrapped.Context = append(wrapped.Context, context)
+	}
+
+	return wrapped
+}
+
+// Merges the errors returned by alternative parsers which received the same input.
+//
+// Only the errors which got furthest into the input are kept, and their expectations are combined into a single description.
+func mergeErrors(input string, errs []error) *ParseError {
+	if len(errs) == 0 {
+		return newParseError("any alternative", describeInput(input, 1))
+	}
+
+	merged := &ParseError{Position: inputStart}
+	furthest := []error{}
+	expected := []string{}
+
+	for _, err := range errs {
+		candidate := &ParseError{Position: inputStart}
+		errors.As(err, &candidate)
+
+		if candidate.Offset < merged.Offset {
+			continue
+		}
+
+		if candidate.Offset > merged.Offset {
+			merged.Position = candidate.Position
+			merged.Found = ""
+			furthest = furthest[:0]
+			expected = expected[:0]
+		}
+
+		furthest = append(furthest, err)
+
+		if candidate.Expected != "" && !slices.Contains(expected, candidate.Expected) {
+			expected = append(expected, candidate.Expected)
+		}
+
+		if merged.Found == "" {
+			merged.Found = candidate.Found
+		}
+	}
+
+	merged.Expected = strings.Join(expected, " or ")
+	merged.Err = errors.Join(furthest...)
+
+	if len(furthest) == 1 {
+		var parseErr *ParseError
+
+		if errors.As(furthest[0], &parseErr) {
+			merged.Context = slices.Clone(parseErr.Context)
+		}
+
+		merged.Err = furthest[0]
+	}
+
+	return merged
+}
+
+// Returns the text consumed by a parser which received input and left next.
+func consumedText(input, next string) string {
+	return input[:len(input)-len(next)]
+}
+
+// Describes the first characters of the input for error reporting, up to the given amount of runes.
+func describeInput(input string, amount int) string {
+	if len(input) == 0 {
+		return endOfInput
+	}
+
+	end := 0
+
+	for i := 0; i < amount && end < len(input); i++ {
+		_, size := utf8.DecodeRuneInString(input[end:])
+		end += size
+	}
+
+	return quote(input[:end])
+}
+
+// Quotes the given text with single quotes, escaping non printable characters.
+func quote(text string) string {
+	quoted := strconv.Quote(text)
+
+	return "'" + strings.ReplaceAll(quoted[1:len(quoted)-1], `\"`, `"`) + "'"
+}
@@ -0,0 +1,204 @@
+package gom
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestPositionAdvance(t *testing.T) {
+	tests := []struct {
+		name     string
+		from     Position
+		consumed string
+		want     Position
+	}{
+		{
+			name:     "same line",
+			from:     inputStart,
+			consumed: "hello",
+			want:     Position{Offset: 5, Line: 1, Column: 6},
+		},
+		{
+			name:     "multiple lines",
+			from:     Position{Offset: 2, Line: 1, Column: 3},
+			consumed: "ab\ncd\nefg",
+			want:     Position{Offset: 11, Line: 3, Column: 4},
+		},
+		{
+			name:     "columns count runes",
+			from:     inputStart,
+			consumed: "héllo",
+			want:     Position{Offset: 6, Line: 1, Column: 6},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := tc.from.advance(tc.consumed)
+
+			if got != tc.want {
+				t.Fatalf("%s: expected %+v, but got %+v", tc.name, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestParseErrorMessage(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *ParseError
+		want string
+	}{
+		{
+			name: "expected and found",
+			err: &ParseError{
+				Position: Position{Offset: 10, Line: 2, Column: 4},
+				Expected: `'foo'`,
+				Found:    `'bar'`,
+			},
+			want: "expected 'foo' but found 'bar' at line 2, column 4",
+		},
+		{
+			name: "context stack",
+			err: &ParseError{
+				Position: Position{Offset: 10, Line: 2, Column: 4},
+				Expected: `'foo'`,
+				Found:    `'bar'`,
+				Context:  []string{"second parser of Pair", "content of Delimited"},
+			},
+			want: "expected 'foo' but found 'bar' at line 2, column 4 in second parser of Pair in content of Delimited",
+		},
+		{
+			name: "custom cause",
+			err: &ParseError{
+				Position: Position{Offset: 0, Line: 1, Column: 1},
+				Err:      fmt.Errorf("invalid number"),
+			},
+			want: "invalid number at line 1, column 1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.err.Error() != tc.want {
+				t.Fatalf("%s: expected %q, but got %q", tc.name, tc.want, tc.err.Error())
+			}
+		})
+	}
+}
+
+func TestWrapError(t *testing.T) {
+	inner := &ParseError{
+		Position: Position{Offset: 3, Line: 1, Column: 4},
+		Expected: `'x'`,
+		Found:    `'y'`,
+		Context:  []string{"inner"},
+	}
+	custom := fmt.Errorf("custom failure")
+
+	tests := []struct {
+		name     string
+		err      error
+		consumed string
+		want     *ParseError
+	}{
+		{
+			name:     "wrap on the same line",
+			err:      inner,
+			consumed: "ab",
+			want: &ParseError{
+				Position: Position{Offset: 5, Line: 1, Column: 6},
+				Expected: `'x'`,
+				Found:    `'y'`,
+				Context:  []string{"inner", "outer"},
+				Err:      inner,
+			},
+		},
+		{
+			name:     "wrap after a line break",
+			err:      inner,
+			consumed: "first\nab",
+			want: &ParseError{
+				Position: Position{Offset: 11, Line: 2, Column: 6},
+				Expected: `'x'`,
+				Found:    `'y'`,
+				Context:  []string{"inner", "outer"},
+				Err:      inner,
+			},
+		},
+		{
+			name:     "wrap non parse errors",
+			err:      custom,
+			consumed: "abc",
+			want: &ParseError{
+				Position: Position{Offset: 3, Line: 1, Column: 4},
+				Context:  []string{"outer"},
+				Err:      custom,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := wrapError(tc.err, tc.consumed, "outer")
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("%s: expected %+v, but got %+v", tc.name, tc.want, got)
+			}
+		})
+	}
+
+	if inner.Offset != 3 || len(inner.Context) != 1 {
+		t.Fatalf("wrapError should not modify the original error, but got %+v", inner)
+	}
+}
+
+func TestParseErrorThroughCombinators(t *testing.T) {
+	parser := Pair(Match("let "), Delimited(Char('('), TakeWhile(func(ch rune) bool { return ch != ')' && ch != '\n' }), Char(')')))
+	_, _, err := parser("let (abc\ndef)")
+
+	var parseErr *ParseError
+
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("expected a parse error, but got %v", err)
+	}
+
+	want := Position{Offset: 8, Line: 1, Column: 9}
+
+	if parseErr.Position != want {
+		t.Fatalf("expected error at %+v, but got %+v", want, parseErr.Position)
+	}
+
+	wantContext := []string{"closer of Delimited", "second parser of Pair"}
+
+	if !reflect.DeepEqual(parseErr.Context, wantContext) {
+		t.Fatalf("expected context %v, but got %v", wantContext, parseErr.Context)
+	}
+
+	var cause *ParseError
+
+	if !errors.As(errors.Unwrap(err), &cause) || cause.Offset != 4 {
+		t.Fatalf("expected the cause relative to the Delimited input, but got %+v", cause)
+	}
+}
+
+func TestParseErrorIs(t *testing.T) {
+	errInvalid := fmt.Errorf("invalid value")
+	failing := func(input string) (string, string, error) {
+		return "", "", errInvalid
+	}
+
+	_, _, err := Preceded(Char('['), Terminated(Parser[string](failing), Char(']')))("[value]")
+
+	if !errors.Is(err, errInvalid) {
+		t.Fatalf("expected the error chain to contain %v, but got %v", errInvalid, err)
+	}
+
+	want := "invalid value at line 1, column 2 in content of Terminated in content of Preceded"
+
+	if err.Error() != want {
+		t.Fatalf("expected %q, but got %q", want, err.Error())
+	}
+}
@@ -0,0 +1,296 @@
+package gom
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Represents the side to which an infix operator groups when it is chained with operators of the same binding power.
+type Associativity int
+
+//   - LEFT: left associative operators group from the left, so "a - b - c" is "(a - b) - c".
+//
+//   - RIGHT: right associative operators group from the right, so "a ^ b ^ c" is "a ^ (b ^ c)".
+
+const (
+	LEFT Associativity = iota
+	RIGHT
+)
+
+// Describes an operator registered in an [ExpressionBuilder].
+type operator[E any] struct {
+	parser        Parser[string]
+	power         uint
+	associativity Associativity
+	unary         func(operand E) E
+	binary        func(left, right E) E
+}
+
+// Builds operator-precedence expression parsers from an operand parser and a set of prefix, infix and postfix operators.
+//
+// Operators with a higher binding power bind tighter than the ones with a lower binding power. Operators of the same
+// kind are tried in registration order, so operators which are prefixes of other operators must be registered after them.
+type ExpressionBuilder[E any] struct {
+	operand Parser[E]
+	prefix  []operator[E]
+	infix   []operator[E]
+	postfix []operator[E]
+}
+
+// Takes the parser for the atoms of the expression, like numbers or parenthesized expressions, and returns a builder without operators.
+func NewExpression[E any](operand Parser[E]) *ExpressionBuilder[E] {
+	return &ExpressionBuilder[E]{operand: operand}
+}
+
+// Registers a prefix operator, whose operand extends over the operators which bind tighter than it.
+func (b *ExpressionBuilder[E]) Prefix(op Parser[string], power uint, build func(operand E) E) *ExpressionBuilder[E] {
+	b.prefix = append(b.prefix, operator[E]{parser: op, power: power, unary: build})
+
+	return b
+}
+
+// Registers an infix operator with its binding power and associativity.
+func (b *ExpressionBuilder[E]) Infix(op Parser[string], power uint, associativity Associativity, build func(left, right E) E) *ExpressionBuilder[E] {
+	b.infix = append(b.infix, operator[E]{parser: op, power: power, associativity: associativity, binary: build})
+
+	return b
+}
+
+// Registers a postfix operator, which applies to the expression on its left when it binds tighter than the operator before it.
+func (b *ExpressionBuilder[E]) Postfix(op Parser[string], power uint, build func(operand E) E) *ExpressionBuilder[E] {
+	b.postfix = append(b.postfix, operator[E]{parser: op, power: power, unary: build})
+
+	return b
+}
+
+// Returns a parser for expressions made of the registered operators.
+//
+// Operators registered afterwards do not affect the returned parser. If an operand is missing, returns empty values for
+// the next string and the expression, and returns an error which merges what the operand and the prefix operators expected.
+func (b *ExpressionBuilder[E]) Parser() Parser[E] {
+	expression := ExpressionBuilder[E]{
+		operand: b.operand,
+		prefix:  slices.Clone(b.prefix),
+		infix:   slices.Clone(b.infix),
+		postfix: slices.Clone(b.postfix),
+	}
+
+	return func(input string) (string, E, error) {
+		return expression.parse(input, input, 0)
+	}
+}
+
+// Parses an expression whose operators bind at least as tight as the minimum power, locating errors relative to the start input.
+func (b *ExpressionBuilder[E]) parse(start, input string, minPower uint) (string, E, error) {
+	var empty E
+
+	next, left, err := b.parseOperand(start, input)
+
+	if err != nil {
+		return "", empty, err
+	}
+
+	for {
+		rest, op, err := matchOperator(start, next, b.postfix, minPower)
+
+		if err != nil {
+			return "", empty, err
+		}
+
+		if op != nil {
+			left = op.unary(left)
+			next = rest
+			continue
+		}
+
+		rest, op, err = matchOperator(start, next, b.infix, minPower)
+
+		if err != nil {
+			return "", empty, err
+		}
+
+		if op == nil {
+			return next, left, nil
+		}
+
+		rightPower := op.power + 1
+
+		if op.associativity == RIGHT {
+			rightPower = op.power
+		}
+
+		rest, right, err := b.parse(start, rest, rightPower)
+
+		if err != nil {
+			return "", empty, err
+		}
+
+		left = op.binary(left, right)
+		next = rest
+	}
+}
+
+// Parses an operand along with the prefix operators before it.
+func (b *ExpressionBuilder[E]) parseOperand(start, input string) (string, E, error) {
+	var empty E
+
+	errs := make([]error, 0, len(b.prefix)+1)
+
+	for _, op := range b.prefix {
+		rest, _, err := op.parser(input)
+
+		if mustPropagate(err) {
+			return "", empty, wrapError(err, consumedText(start, input), "operator of Expression")
+		}
+
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+
+		if len(rest) == len(input) {
+			return "", empty, noProgressError(consumedText(start, input), "operator of Expression")
+		}
+
+		next, operand, err := b.parse(start, rest, op.power+1)
+
+		if err != nil {
+			return "", empty, err
+		}
+
+		return next, op.unary(operand), nil
+	}
+
+	next, operand, err := b.operand(input)
+
+	if mustPropagate(err) {
+		return "", empty, wrapError(err, consumedText(start, input), "operand of Expression")
+	}
+
+	if err != nil {
+		return "", empty, wrapError(mergeErrors(input, append(errs, err)), consumedText(start, input), "operand of Expression")
+	}
+
+	return next, operand, nil
+}
+
+// Returns the first operator which matches the input, or nil if none does or if it binds looser than the minimum power.
+//
+// Every operator is tried regardless of its power, so a looser operator is never taken for a tighter one which is a prefix of it.
+func matchOperator[E any](start, input string, operators []operator[E], minPower uint) (string, *operator[E], error) {
+	for i, op := range operators {
+		rest, _, err := op.parser(input)
+
+		if mustPropagate(err) {
+			return "", nil, wrapError(err, consumedText(start, input), "operator of Expression")
+		}
+
+		if err != nil {
+			continue
+		}
+
+		if len(rest) == len(input) {
+			return "", nil, noProgressError(consumedText(start, input), "operator of Expression")
+		}
+
+		if op.power < minPower {
+			return input, nil, nil
+		}
+
+		return rest, &operators[i], nil
+	}
+
+	return input, nil, nil
+}
+
+// Takes an operand parser and an operator parser which returns the function combining two operands, and returns a parser
+// which matches one or more operands separated by operators, combining them from the left.
+//
+// If an operator is not followed by an operand, returns empty values for the next string and the result, and returns
+// the operand error wrapped with the failing operand.
+func ChainL1[E any](operand Parser[E], op Parser[func(left, right E) E]) Parser[E] {
+	return func(input string) (string, E, error) {
+		var empty E
+
+		next, result, err := operand(input)
+
+		if err != nil {
+			return "", empty, wrapError(err, "", "operand 1 of ChainL1")
+		}
+
+		for count := 2; ; count++ {
+			rest, combine, err := op(next)
+
+			if mustPropagate(err) {
+				return "", empty, wrapError(err, consumedText(input, next), fmt.Sprintf("operator %d of ChainL1", count-1))
+			}
+
+			if err != nil {
+				return next, result, nil
+			}
+
+			n, right, err := operand(rest)
+
+			if err != nil {
+				return "", empty, wrapError(err, consumedText(input, rest), fmt.Sprintf("operand %d of ChainL1", count))
+			}
+
+			if len(n) == len(next) {
+				return "", empty, noProgressError(consumedText(input, next), fmt.Sprintf("operator %d of ChainL1", count-1))
+			}
+
+			result = combine(result, right)
+			next = n
+		}
+	}
+}
+
+// Same parsing process than [ChainL1] but combines the operands from the right.
+func ChainR1[E any](operand Parser[E], op Parser[func(left, right E) E]) Parser[E] {
+	return func(input string) (string, E, error) {
+		var empty E
+
+		next, first, err := operand(input)
+
+		if err != nil {
+			return "", empty, wrapError(err, "", "operand 1 of ChainR1")
+		}
+
+		operands := []E{first}
+		combiners := []func(left, right E) E{}
+
+		for count := 2; ; count++ {
+			rest, combine, err := op(next)
+
+			if mustPropagate(err) {
+				return "", empty, wrapError(err, consumedText(input, next), fmt.Sprintf("operator %d of ChainR1", count-1))
+			}
+
+			if err != nil {
+				break
+			}
+
+			n, right, err := operand(rest)
+
+			if err != nil {
+				return "", empty, wrapError(err, consumedText(input, rest), fmt.Sprintf("operand %d of ChainR1", count))
+			}
+
+			if len(n) == len(next) {
+				return "", empty, noProgressError(consumedText(input, next), fmt.Sprintf("operator %d of ChainR1", count-1))
+			}
+
+			operands = append(operands, right)
+			combiners = append(combiners, combine)
+			next = n
+		}
+
+		result := operands[len(operands)-1]
+
+		for i := len(combiners) - 1; i >= 0; i-- {
+			result = combiners[i](operands[i], result)
+		}
+
+		return next, result, nil
+	}
+}
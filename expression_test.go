@@ -0,0 +1,178 @@
+package gom
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+	"unicode"
+)
+
+func arithmetic() Parser[string] {
+	number := StrictTakeWhile(unicode.IsDigit)
+	binary := func(op string) func(left, right string) string {
+		return func(left, right string) string { return "(" + left + op + right + ")" }
+	}
+
+	return NewExpression(number).
+		Infix(Char('+'), 1, LEFT, binary("+")).
+		Infix(Char('-'), 1, LEFT, binary("-")).
+		Infix(Match("**"), 3, RIGHT, binary("**")).
+		Infix(Char('*'), 2, LEFT, binary("*")).
+		Prefix(Char('-'), 2, func(operand string) string { return "(-" + operand + ")" }).
+		Postfix(Char('!'), 4, func(operand string) string { return "(" + operand + "!)" }).
+		Parser()
+}
+
+func TestExpression(t *testing.T) {
+	tests := []ParserTestCase[string, string]{
+		{
+			name:  "left associative",
+			input: "1-2-3;",
+			want: ParseResult[string]{
+				next:   ";",
+				parsed: "((1-2)-3)",
+				err:    nil,
+			},
+		},
+		{
+			name:  "right associative",
+			input: "2**3**4",
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "(2**(3**4))",
+				err:    nil,
+			},
+		},
+		{
+			name:  "binding powers",
+			input: "1+2*3!-4",
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "((1+(2*(3!)))-4)",
+				err:    nil,
+			},
+		},
+		{
+			name:  "prefix operator",
+			input: "-2**2*3",
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "((-(2**2))*3)",
+				err:    nil,
+			},
+		},
+		{
+			name:  "missing operand error",
+			input: "1+*",
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "",
+				err: &ParseError{
+					Position: Position{Offset: 2, Line: 1, Column: 3},
+					Expected: "'-' or character matching the predicate",
+					Found:    `'*'`,
+					Context:  []string{"operand of Expression"},
+					Err: &ParseError{
+						Position: inputStart,
+						Expected: "'-' or character matching the predicate",
+						Found:    `'*'`,
+						Err: errors.Join(
+							newParseError(`'-'`, `'*'`),
+							newParseError("character matching the predicate", `'*'`),
+						),
+					},
+				},
+			},
+		},
+	}
+
+	ExecParserTestCases(t, func(string) Parser[string] { return arithmetic() }, tests)
+}
+
+func TestExpressionOverlappingOperators(t *testing.T) {
+	binary := func(op string) func(left, right string) string {
+		return func(left, right string) string { return "(" + left + op + right + ")" }
+	}
+
+	// The looser "||" starts like the tighter "|", which must not match its first byte.
+	logic := NewExpression(StrictTakeWhile(unicode.IsLetter)).
+		Infix(Match("||"), 2, LEFT, binary("||")).
+		Infix(Match("&&"), 3, LEFT, binary("&&")).
+		Infix(Char('|'), 4, LEFT, binary("|")).
+		Parser()
+
+	tests := []ParserTestCase[string, string]{
+		{
+			name:  "looser operator after a tighter one",
+			input: "a&&b||c",
+			want:  ParseResult[string]{next: "", parsed: "((a&&b)||c)", err: nil},
+		},
+		{
+			name:  "tighter operator inside a looser one",
+			input: "a||b|c&&d",
+			want:  ParseResult[string]{next: "", parsed: "(a||((b|c)&&d))", err: nil},
+		},
+	}
+
+	ExecParserTestCases(t, func(string) Parser[string] { return logic }, tests)
+}
+
+func TestChain(t *testing.T) {
+	number := Map(StrictTakeWhile(unicode.IsDigit), func(digits string) int {
+		n, _ := strconv.Atoi(digits)
+		return n
+	})
+	subtract := Value(func(left, right int) int { return left - right }, Char('-'))
+
+	tests := []struct {
+		name   string
+		input  string
+		parser Parser[int]
+		want   ParseResult[int]
+	}{
+		{
+			name:   "left chain",
+			input:  "10-3-2;",
+			parser: ChainL1(number, subtract),
+			want:   ParseResult[int]{next: ";", parsed: 5, err: nil},
+		},
+		{
+			name:   "right chain",
+			input:  "10-3-2;",
+			parser: ChainR1(number, subtract),
+			want:   ParseResult[int]{next: ";", parsed: 9, err: nil},
+		},
+		{
+			name:   "missing operand error",
+			input:  "10-;",
+			parser: ChainL1(number, subtract),
+			want: ParseResult[int]{
+				next:   "",
+				parsed: 0,
+				err: &ParseError{
+					Position: Position{Offset: 3, Line: 1, Column: 4},
+					Expected: "character matching the predicate",
+					Found:    `';'`,
+					Context:  []string{"operand 2 of ChainL1"},
+					Err: &ParseError{
+						Position: inputStart,
+						Expected: "character matching the predicate",
+						Found:    `';'`,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			next, parsed, err := tc.parser(tc.input)
+			got := ParseResult[int]{next, parsed, err}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("%s: expected %+v, but got %+v", tc.name, tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package gom
+
+import (
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+// Parses JSON documents into maps, slices, strings, float64, booleans and nil.
+func jsonGrammar() Parser[any] {
+	value := NewRule[any]()
+	whitespace := TakeWhile(unicode.IsSpace)
+	token := func(ch rune) Parser[string] {
+		return Delimited(whitespace, Char(ch), whitespace)
+	}
+
+	str := Delimited(Char('"'), TakeTill(func(ch rune) bool { return ch == '"' }), Char('"'))
+	number := Map(Recognize(Tuple3(Opt(Char('-')), StrictTakeWhile(unicode.IsDigit), Opt(Preceded(Char('.'), StrictTakeWhile(unicode.IsDigit))))), func(parsed string) any {
+		n, _ := strconv.ParseFloat(parsed, 64)
+		return n
+	})
+	array := Map(Delimited(token('['), SeparatedList0(value.Parse, token(','), DENY_TRAILING), token(']')), func(items []any) any {
+		return items
+	})
+	member := SeparatedPair(str, token(':'), value.Parse)
+	object := Map(Delimited(token('{'), SeparatedList0(member, token(','), DENY_TRAILING), token('}')), func(members []PairResult[string, any]) any {
+		parsed := make(map[string]any, len(members))
+
+		for _, m := range members {
+			parsed[m.First] = m.Second
+		}
+
+		return parsed
+	})
+
+	value.Define(Delimited(whitespace, Alt(ParsersList[any]{
+		object,
+		array,
+		Map(str, func(parsed string) any { return parsed }),
+		number,
+		Value[string, any](true, Match("true")),
+		Value[string, any](false, Match("false")),
+		Value[string, any](nil, Match("null")),
+	}), whitespace))
+
+	return value.Parse
+}
+
+// Parses CSV records of unquoted fields separated by commas, each one terminated by a new line.
+func csvGrammar() Parser[[][]string] {
+	field := TakeTill(func(ch rune) bool { return ch == ',' || ch == '\n' })
+	record := SeparatedList1(field, Char(','), DENY_TRAILING)
+
+	return Many(Terminated(record, Char('\n')))
+}
+
+// Evaluates arithmetic expressions over integers with +, -, *, / and parentheses.
+func arithmeticGrammar() Parser[int] {
+	expression := NewRule[int]()
+	whitespace := TakeWhile(unicode.IsSpace)
+	symbol := func(ch rune) Parser[string] {
+		return Delimited(whitespace, Char(ch), whitespace)
+	}
+
+	number := Map(StrictTakeWhile(unicode.IsDigit), func(digits string) int {
+		n, _ := strconv.Atoi(digits)
+		return n
+	})
+	operand := Alt(ParsersList[int]{number, Delimited(symbol('('), expression.Parse, symbol(')'))})
+
+	expression.Define(NewExpression(operand).
+		Infix(symbol('+'), 1, LEFT, func(l, r int) int { return l + r }).
+		Infix(symbol('-'), 1, LEFT, func(l, r int) int { return l - r }).
+		Infix(symbol('*'), 2, LEFT, func(l, r int) int { return l * r }).
+		Infix(symbol('/'), 2, LEFT, func(l, r int) int { return l / r }).
+		Prefix(symbol('-'), 3, func(e int) int { return -e }).
+		Parser())
+
+	return expression.Parse
+}
+
+func TestGrammars(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		parser func(input string) (string, any, error)
+		want   ParseResult[any]
+	}{
+		{
+			name:  "json",
+			input: `{"name": "gom", "tags": ["parser", "go"], "stars": 4.5, "fork": false, "parent": null}`,
+			parser: func(input string) (string, any, error) {
+				return jsonGrammar()(input)
+			},
+			want: ParseResult[any]{
+				next: "",
+				parsed: map[string]any{
+					"name":   "gom",
+					"tags":   []any{"parser", "go"},
+					"stars":  4.5,
+					"fork":   false,
+					"parent": nil,
+				},
+				err: nil,
+			},
+		},
+		{
+			name:  "csv",
+			input: "name,lang\ngom,go\n",
+			parser: func(input string) (string, any, error) {
+				return csvGrammar()(input)
+			},
+			want: ParseResult[any]{
+				next:   "",
+				parsed: [][]string{{"name", "lang"}, {"gom", "go"}},
+				err:    nil,
+			},
+		},
+		{
+			name:  "arithmetic",
+			input: "2 * (3 + 4) - -10 / 5",
+			parser: func(input string) (string, any, error) {
+				return arithmeticGrammar()(input)
+			},
+			want: ParseResult[any]{
+				next:   "",
+				parsed: 16,
+				err:    nil,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			next, parsed, err := tc.parser(tc.input)
+			got := ParseResult[any]{next, parsed, err}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("%s: expected %+v, but got %+v", tc.name, tc.want, got)
+			}
+		})
+	}
+}
+
+func BenchmarkGrammars(b *testing.B) {
+	json := "[" + strings.Repeat(`{"id": 12345, "name": "gom", "tags": ["parser", "go"], "active": true}, `, 1<<8) + "null]"
+	csv := strings.Repeat("12345,gom,parser combinators,go\n", 1<<10)
+	arithmetic := strings.Repeat("(1 + 2) * 3 - 4 / 2 + ", 1<<8) + "1"
+
+	ExecParserBenchmarks(b, []ParserBenchmark{
+		NewParserBenchmark("JSON", json, jsonGrammar()),
+		NewParserBenchmark("CSV", csv, csvGrammar()),
+		NewParserBenchmark("Arithmetic", arithmetic, arithmeticGrammar()),
+	})
+}
@@ -0,0 +1,236 @@
+package gom
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// Describes the signature function for parsers over any kind of input.
+//
+// [Parser] is the signature of parsers over strings, and the plain combinators only compose string parsers.
+// Byte, rune and token slices are parsed by input parsers built with the Text and Token families of primitives,
+// and composed with the Input family of combinators, like [InputPair] and [InputMany].
+type InputParser[I, O any] func(input I) (I, O, error)
+
+// Describes the inputs made of UTF-8 encoded text: strings and byte slices.
+type Text interface {
+	~string | ~[]byte
+}
+
+// Decodes the first rune of a text input, returning it along with its size in bytes.
+func decodeRune[I Text](input I) (rune, int) {
+	if len(input) > 0 && input[0] < utf8.RuneSelf {
+		return rune(input[0]), 1
+	}
+
+	var buffer [utf8.UTFMax]byte
+	n := copy(buffer[:], input)
+
+	return utf8.DecodeRune(buffer[:n])
+}
+
+// Returns the index of the first occurrence of target in input, or -1 if it is not present.
+func indexText[I Text](input, target I) int {
+	switch in := any(input).(type) {
+	case string:
+		return strings.Index(in, string(target))
+	case []byte:
+		return bytes.Index(in, []byte(target))
+	default:
+		return strings.Index(string(input), string(target))
+	}
+}
+
+// Describes the first characters of a text input for error reporting, up to the given amount of runes.
+func describeText[I Text](input I, amount int) string {
+	return describeInput(string(input[:min(len(input), amount*utf8.UTFMax)]), amount)
+}
+
+// Same parsing process than [Char] but over any text input.
+func TextChar[I Text](target rune) InputParser[I, I] {
+	return func(input I) (I, I, error) {
+		var empty I
+
+		if len(input) == 0 {
+			return empty, empty, newParseError(quote(string(target)), describeText(input, 1))
+		}
+
+		ch, size := decodeRune(input)
+		parsed := input[:size]
+
+		if ch != target {
+			return empty, empty, newParseError(quote(string(target)), quote(string(parsed)))
+		}
+
+		return input[size:], parsed, nil
+	}
+}
+
+// Same parsing process than [Match] but over any text input.
+func TextMatch[I Text](target I) InputParser[I, I] {
+	return func(input I) (I, I, error) {
+		var empty I
+
+		if len(target) > len(input) {
+			return empty, empty, newParseError(quote(string(target)), describeText(input, maxFoundRunes))
+		}
+
+		parsed := input[:len(target)]
+
+		if string(parsed) != string(target) {
+			return empty, empty, newParseError(quote(string(target)), quote(string(parsed)))
+		}
+
+		return input[len(target):], parsed, nil
+	}
+}
+
+// Same parsing process than [Take] but over any text input.
+func TextTake[I Text](amount uint) InputParser[I, I] {
+	return func(input I) (I, I, error) {
+		var empty I
+		end := 0
+
+		for i := uint(0); i < amount; i++ {
+			if end == len(input) {
+				return empty, empty, newParseError(fmt.Sprintf("%d characters", amount), describeText(input, maxFoundRunes))
+			}
+
+			_, size := decodeRune(input[end:])
+			end += size
+		}
+
+		return input[end:], input[:end], nil
+	}
+}
+
+// Same parsing process than [TakeBytes] but over any text input.
+func TextTakeBytes[I Text](amount uint) InputParser[I, I] {
+	return func(input I) (I, I, error) {
+		var empty I
+
+		if amount > uint(len(input)) {
+			return empty, empty, newParseError(fmt.Sprintf("%d bytes", amount), describeText(input, maxFoundRunes))
+		}
+
+		return input[amount:], input[:amount], nil
+	}
+}
+
+// Same parsing process than [OneOf] but over any text input.
+func TextOneOf[I Text](characters string) InputParser[I, I] {
+	return func(input I) (I, I, error) {
+		var empty I
+
+		if len(input) == 0 {
+			return empty, empty, newParseError("one of "+quote(characters), describeText(input, 1))
+		}
+
+		ch, size := decodeRune(input)
+		parsed := input[:size]
+
+		if !strings.ContainsRune(characters, ch) {
+			return empty, empty, newParseError("one of "+quote(characters), quote(string(parsed)))
+		}
+
+		return input[size:], parsed, nil
+	}
+}
+
+// Same parsing process than [NoneOf] but over any text input.
+func TextNoneOf[I Text](characters string) InputParser[I, I] {
+	return func(input I) (I, I, error) {
+		var empty I
+
+		if len(input) == 0 {
+			return empty, empty, newParseError("none of "+quote(characters), describeText(input, 1))
+		}
+
+		ch, size := decodeRune(input)
+		parsed := input[:size]
+
+		if strings.ContainsRune(characters, ch) {
+			return empty, empty, newParseError("none of "+quote(characters), quote(string(parsed)))
+		}
+
+		return input[size:], parsed, nil
+	}
+}
+
+// Same parsing process than [TakeUntil] but over any text input.
+func TextTakeUntil[I Text](target I) InputParser[I, I] {
+	return func(input I) (I, I, error) {
+		index := indexText(input, target)
+
+		if index < 0 {
+			return input, input[:0], nil
+		}
+
+		return input[index:], input[:index], nil
+	}
+}
+
+// Same parsing process than [StrictTakeUntil] but over any text input.
+func TextStrictTakeUntil[I Text](target I) InputParser[I, I] {
+	return func(input I) (I, I, error) {
+		index := indexText(input, target)
+
+		if index < 0 {
+			var empty I
+			err := newParseError(quote(string(target)), endOfInput)
+			err.Position = inputStart.advance(string(input))
+
+			return empty, empty, err
+		}
+
+		return input[index:], input[:index], nil
+	}
+}
+
+// Same parsing process than [TakeWhile] but over any text input.
+func TextTakeWhile[I Text](predicate Predicate) InputParser[I, I] {
+	return func(input I) (I, I, error) {
+		parsed, _ := evalPredicate(input, FLEX, UNCOMPLY, predicate)
+
+		return input[len(parsed):], parsed, nil
+	}
+}
+
+// Same parsing process than [StrictTakeWhile] but over any text input.
+func TextStrictTakeWhile[I Text](predicate Predicate) InputParser[I, I] {
+	return func(input I) (I, I, error) {
+		parsed, err := evalPredicate(input, STRICT, UNCOMPLY, predicate)
+
+		if err != nil {
+			var empty I
+			return empty, empty, err
+		}
+
+		return input[len(parsed):], parsed, nil
+	}
+}
+
+// Same parsing process than [TakeTill] but over any text input.
+func TextTakeTill[I Text](predicate Predicate) InputParser[I, I] {
+	return func(input I) (I, I, error) {
+		parsed, _ := evalPredicate(input, FLEX, COMPLY, predicate)
+
+		return input[len(parsed):], parsed, nil
+	}
+}
+
+// Same parsing process than [StrictTakeTill] but over any text input.
+func TextStrictTakeTill[I Text](predicate Predicate) InputParser[I, I] {
+	return func(input I) (I, I, error) {
+		parsed, err := evalPredicate(input, STRICT, COMPLY, predicate)
+
+		if err != nil {
+			var empty I
+			return empty, empty, err
+		}
+
+		return input[len(parsed):], parsed, nil
+	}
+}
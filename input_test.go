@@ -0,0 +1,185 @@
+package gom
+
+import (
+	"testing"
+	"unicode"
+)
+
+func TestTextChar(t *testing.T) {
+	tests := []InputParserTestCase[[]byte, rune, []byte]{
+		{
+			name:   "successful parse",
+			input:  []byte("élan"),
+			params: 'é',
+			want: InputParseResult[[]byte, []byte]{
+				next:   []byte("lan"),
+				parsed: []byte("é"),
+				err:    nil,
+			},
+		},
+		{
+			name:   "character does not match error",
+			input:  []byte("abc"),
+			params: 'x',
+			want: InputParseResult[[]byte, []byte]{
+				next:   nil,
+				parsed: nil,
+				err: &ParseError{
+					Position: inputStart,
+					Expected: `'x'`,
+					Found:    `'a'`,
+				},
+			},
+		},
+	}
+
+	ExecInputParserTestCases(t, TextChar[[]byte], tests)
+}
+
+func TestTextMatch(t *testing.T) {
+	tests := []InputParserTestCase[[]byte, []byte, []byte]{
+		{
+			name:   "successful parse",
+			input:  []byte("GET /index"),
+			params: []byte("GET"),
+			want: InputParseResult[[]byte, []byte]{
+				next:   []byte(" /index"),
+				parsed: []byte("GET"),
+				err:    nil,
+			},
+		},
+		{
+			name:   "too short input error",
+			input:  []byte("GE"),
+			params: []byte("GET"),
+			want: InputParseResult[[]byte, []byte]{
+				next:   nil,
+				parsed: nil,
+				err: &ParseError{
+					Position: inputStart,
+					Expected: `'GET'`,
+					Found:    `'GE'`,
+				},
+			},
+		},
+	}
+
+	ExecInputParserTestCases(t, TextMatch[[]byte], tests)
+}
+
+func TestTextTake(t *testing.T) {
+	tests := []InputParserTestCase[[]byte, uint, []byte]{
+		{
+			name:   "successful parse",
+			input:  []byte("αβγ"),
+			params: 2,
+			want: InputParseResult[[]byte, []byte]{
+				next:   []byte("γ"),
+				parsed: []byte("αβ"),
+				err:    nil,
+			},
+		},
+	}
+
+	ExecInputParserTestCases(t, TextTake[[]byte], tests)
+}
+
+func TestTextOneOf(t *testing.T) {
+	tests := []InputParserTestCase[[]byte, string, []byte]{
+		{
+			name:   "successful parse",
+			input:  []byte("γδ"),
+			params: "αβγ",
+			want: InputParseResult[[]byte, []byte]{
+				next:   []byte("δ"),
+				parsed: []byte("γ"),
+				err:    nil,
+			},
+		},
+		{
+			name:   "none character match error",
+			input:  []byte("δ"),
+			params: "αβγ",
+			want: InputParseResult[[]byte, []byte]{
+				next:   nil,
+				parsed: nil,
+				err: &ParseError{
+					Position: inputStart,
+					Expected: "one of 'αβγ'",
+					Found:    `'δ'`,
+				},
+			},
+		},
+	}
+
+	ExecInputParserTestCases(t, TextOneOf[[]byte], tests)
+}
+
+func TestTextStrictTakeUntil(t *testing.T) {
+	tests := []InputParserTestCase[[]byte, []byte, []byte]{
+		{
+			name:   "successful parse",
+			input:  []byte("key=value"),
+			params: []byte("="),
+			want: InputParseResult[[]byte, []byte]{
+				next:   []byte("=value"),
+				parsed: []byte("key"),
+				err:    nil,
+			},
+		},
+		{
+			name:   "params dont match error",
+			input:  []byte("key\nvalue"),
+			params: []byte("="),
+			want: InputParseResult[[]byte, []byte]{
+				next:   nil,
+				parsed: nil,
+				err: &ParseError{
+					Position: Position{Offset: 9, Line: 2, Column: 6},
+					Expected: `'='`,
+					Found:    "end of input",
+				},
+			},
+		},
+	}
+
+	ExecInputParserTestCases(t, TextStrictTakeUntil[[]byte], tests)
+}
+
+func TestTextTakeWhile(t *testing.T) {
+	tests := []InputParserTestCase[[]byte, Predicate, []byte]{
+		{
+			name:   "successful parse",
+			input:  []byte("ñandú42"),
+			params: unicode.IsLetter,
+			want: InputParseResult[[]byte, []byte]{
+				next:   []byte("42"),
+				parsed: []byte("ñandú"),
+				err:    nil,
+			},
+		},
+	}
+
+	ExecInputParserTestCases(t, TextTakeWhile[[]byte], tests)
+}
+
+func TestTextStrictTakeWhile(t *testing.T) {
+	tests := []InputParserTestCase[[]byte, Predicate, []byte]{
+		{
+			name:   "predicate dont match error",
+			input:  []byte("42"),
+			params: unicode.IsLetter,
+			want: InputParseResult[[]byte, []byte]{
+				next:   nil,
+				parsed: nil,
+				err: &ParseError{
+					Position: inputStart,
+					Expected: "character matching the predicate",
+					Found:    `'4'`,
+				},
+			},
+		},
+	}
+
+	ExecInputParserTestCases(t, TextStrictTakeWhile[[]byte], tests)
+}
@@ -0,0 +1,81 @@
+package gom
+
+import (
+	"sort"
+	"strings"
+	"unicode/utf8"
+)
+
+// Represents the portion of the source covered by a parsed value, from its first character up to right after its last one.
+type Span struct {
+	Start Position
+	End   Position
+}
+
+// Represents a parsed value along with the span of the source it was parsed from.
+type SpannedResult[O any] struct {
+	Value O
+	Span  Span
+}
+
+// Wraps a source string to track the position of every input parsed from it.
+//
+// Parsers consume their input from the beginning and pass the rest along, so the inputs received by the parsers of a
+// grammar are suffixes of the source. Located turns those suffixes back into offsets, lines and columns of the source.
+type Located struct {
+	source     string
+	lineStarts []int
+}
+
+// Takes the source which is going to be parsed and returns a located wrapper for it.
+func NewLocated(source string) *Located {
+	lineStarts := []int{0}
+
+	for offset := 0; ; {
+		index := strings.IndexByte(source[offset:], '\n')
+
+		if index < 0 {
+			break
+		}
+
+		offset += index + 1
+		lineStarts = append(lineStarts, offset)
+	}
+
+	return &Located{source: source, lineStarts: lineStarts}
+}
+
+// Returns the wrapped source.
+func (l *Located) Source() string {
+	return l.source
+}
+
+// Takes an input which is a suffix of the source and returns the position of its first character inside the source.
+func (l *Located) Position(input string) Position {
+	offset := max(len(l.source)-len(input), 0)
+	line := sort.SearchInts(l.lineStarts, offset+1) - 1
+
+	return Position{
+		Offset: offset,
+		Line:   line + 1,
+		Column: 1 + utf8.RuneCountInString(l.source[l.lineStarts[line]:offset]),
+	}
+}
+
+// Takes the input received by a parser and the input it left, and returns the span of the source consumed between them.
+func (l *Located) Span(input, next string) Span {
+	return Span{Start: l.Position(input), End: l.Position(next)}
+}
+
+// Takes a located source and a parser over it, and returns a parser which outputs the parsed value along with its span in the source.
+func Spanned[O any](located *Located, parser Parser[O]) Parser[SpannedResult[O]] {
+	return func(input string) (string, SpannedResult[O], error) {
+		next, parsed, err := parser(input)
+
+		if err != nil {
+			return "", SpannedResult[O]{}, err
+		}
+
+		return next, SpannedResult[O]{Value: parsed, Span: located.Span(input, next)}, nil
+	}
+}
@@ -0,0 +1,100 @@
+package gom
+
+import (
+	"reflect"
+	"testing"
+	"unicode"
+)
+
+func TestLocatedPosition(t *testing.T) {
+	source := "first\nsécond\n\nlast"
+	located := NewLocated(source)
+
+	tests := []struct {
+		name  string
+		input string
+		want  Position
+	}{
+		{
+			name:  "whole source",
+			input: source,
+			want:  Position{Offset: 0, Line: 1, Column: 1},
+		},
+		{
+			name:  "line break",
+			input: source[5:],
+			want:  Position{Offset: 5, Line: 1, Column: 6},
+		},
+		{
+			name:  "multi-byte characters",
+			input: source[10:],
+			want:  Position{Offset: 10, Line: 2, Column: 4},
+		},
+		{
+			name:  "empty line",
+			input: source[14:],
+			want:  Position{Offset: 14, Line: 3, Column: 1},
+		},
+		{
+			name:  "end of source",
+			input: "",
+			want:  Position{Offset: 19, Line: 4, Column: 5},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := located.Position(tc.input)
+
+			if got != tc.want {
+				t.Fatalf("%s: expected %+v, but got %+v", tc.name, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestSpanned(t *testing.T) {
+	source := "foo bar\nbaz"
+	located := NewLocated(source)
+	word := Spanned(located, Terminated(StrictTakeWhile(unicode.IsLetter), TakeWhile(unicode.IsSpace)))
+
+	tests := []struct {
+		name  string
+		input string
+		want  ParseResult[[]SpannedResult[string]]
+	}{
+		{
+			name:  "successful parse",
+			input: source,
+			want: ParseResult[[]SpannedResult[string]]{
+				next: "",
+				parsed: []SpannedResult[string]{
+					{
+						Value: "foo",
+						Span:  Span{Start: Position{Offset: 0, Line: 1, Column: 1}, End: Position{Offset: 4, Line: 1, Column: 5}},
+					},
+					{
+						Value: "bar",
+						Span:  Span{Start: Position{Offset: 4, Line: 1, Column: 5}, End: Position{Offset: 8, Line: 2, Column: 1}},
+					},
+					{
+						Value: "baz",
+						Span:  Span{Start: Position{Offset: 8, Line: 2, Column: 1}, End: Position{Offset: 11, Line: 2, Column: 4}},
+					},
+				},
+				err: nil,
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			next, parsed, err := Many(word)(tc.input)
+			got := ParseResult[[]SpannedResult[string]]{next, parsed, err}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("%s: expected %+v, but got %+v", tc.name, tc.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package gom
+
+import (
+	"sync"
+	"sync/atomic"
+	"unsafe"
+)
+
+// Source of the identities which tell memoized parsers apart in a [MemoTable].
+var memoIDs atomic.Uint64
+
+// Identifies a memoized result: the parser which produced it and the position of its input, measured from the end.
+//
+// Every input received during a single parse is a suffix of the same source, so its length identifies its position.
+type memoKey struct {
+	id        uint64
+	remaining int
+}
+
+// Holds the outcome of a memoized parser over an input.
+type memoEntry struct {
+	next   string
+	parsed any
+	err    error
+}
+
+// Holds the results cached while parsing a source, along with the amount of parses of the source in progress.
+//
+// Stored keeps the keys of the entries in the order they were cached, so the entries cached after a point can be discarded.
+type memoSession struct {
+	entries map[memoKey]memoEntry
+	stored  []memoKey
+	parses  int
+}
+
+// Caches the results of memoized parsers while a parse is in progress.
+//
+// Every parse started with [Packrat] gets its own cache, which is discarded once it finishes, so a table can be shared
+// by a whole grammar and used concurrently. Parses are told apart by their source: nested parses of the same source,
+// or of a suffix of it, share the cache of the outermost one.
+type MemoTable struct {
+	mutex    sync.Mutex
+	sessions map[uintptr]*memoSession
+}
+
+// Returns an empty memo table.
+func NewMemoTable() *MemoTable {
+	return &MemoTable{sessions: map[uintptr]*memoSession{}}
+}
+
+// Identifies the source of an input by the address where it ends, which is shared by all of its suffixes.
+//
+// The address is only used while a parse holds the source, so the memory cannot be reused by another string meanwhile.
+func sourceOf(input string) uintptr {
+	return uintptr(unsafe.Pointer(unsafe.StringData(input))) + uintptr(len(input))
+}
+
+// Starts a parse of the source of the input, and returns the function which finishes it.
+//
+// The cache of the source is created by its outermost parse, and discarded when that parse finishes.
+func (t *MemoTable) begin(input string) func() {
+	source := sourceOf(input)
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	session, ok := t.sessions[source]
+
+	if !ok {
+		session = &memoSession{entries: map[memoKey]memoEntry{}}
+		t.sessions[source] = session
+	}
+
+	session.parses++
+
+	return func() {
+		t.mutex.Lock()
+		defer t.mutex.Unlock()
+
+		if session.parses--; session.parses == 0 {
+			delete(t.sessions, source)
+		}
+	}
+}
+
+// Returns the result cached for the parser over the input, if a parse of its source is in progress and has cached it.
+func (t *MemoTable) lookup(id uint64, input string) (memoEntry, bool) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	session, ok := t.sessions[sourceOf(input)]
+
+	if !ok {
+		return memoEntry{}, false
+	}
+
+	entry, ok := session.entries[memoKey{id: id, remaining: len(input)}]
+
+	return entry, ok
+}
+
+// Caches the result of the parser over the input, if a parse of its source is in progress.
+func (t *MemoTable) store(id uint64, input string, entry memoEntry) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	session, ok := t.sessions[sourceOf(input)]
+
+	if !ok {
+		return
+	}
+
+	key := memoKey{id: id, remaining: len(input)}
+
+	if _, ok := session.entries[key]; !ok {
+		session.stored = append(session.stored, key)
+	}
+
+	session.entries[key] = entry
+}
+
+// Returns the amount of entries cached so far by the parse of the source of the input, to discard the later ones with [MemoTable.rollback].
+func (t *MemoTable) mark(input string) int {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if session, ok := t.sessions[sourceOf(input)]; ok {
+		return len(session.stored)
+	}
+
+	return 0
+}
+
+// Discards the entries cached by the parse of the source of the input since the given mark.
+func (t *MemoTable) rollback(input string, mark int) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	session, ok := t.sessions[sourceOf(input)]
+
+	if !ok || mark >= len(session.stored) {
+		return
+	}
+
+	for _, key := range session.stored[mark:] {
+		delete(session.entries, key)
+	}
+
+	session.stored = session.stored[:mark]
+}
+
+// Takes a memo table and a parser, and returns a parser which caches the results of the given parser in the table.
+//
+// Running the returned parser again over the same position of the input returns the cached result instead of parsing it again,
+// so backtracking combinators like [Alt] do not repeat the work of shared branches. Results are only cached while a parse
+// started with [Packrat] is in progress; otherwise the given parser runs every time.
+func Memo[O any](table *MemoTable, parser Parser[O]) Parser[O] {
+	id := memoIDs.Add(1)
+
+	return func(input string) (string, O, error) {
+		if entry, ok := table.lookup(id, input); ok {
+			parsed, _ := entry.parsed.(O)
+			return entry.next, parsed, entry.err
+		}
+
+		next, parsed, err := parser(input)
+		table.store(id, input, memoEntry{next: next, parsed: parsed, err: err})
+
+		return next, parsed, err
+	}
+}
+
+// Takes a memo table and a parser, and returns a parser which runs the given parser with a fresh cache in the table,
+// discarding it once it finishes.
+//
+// Wrap the entry point of a grammar whose parsers are memoized with the table to parse each input in linear time.
+// A Packrat nested in a parse of the same source reuses the cache of the outer parse.
+func Packrat[O any](table *MemoTable, parser Parser[O]) Parser[O] {
+	return func(input string) (string, O, error) {
+		defer table.begin(input)()
+
+		return parser(input)
+	}
+}
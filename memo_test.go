@@ -0,0 +1,136 @@
+package gom
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"unicode"
+)
+
+func TestMemo(t *testing.T) {
+	table := NewMemoTable()
+	calls := 0
+	word := Memo(table, func(input string) (string, string, error) {
+		calls++
+		return StrictTakeWhile(unicode.IsLetter)(input)
+	})
+
+	// Both branches start with the same word, so the second one reuses the result of the first one.
+	statement := Packrat(table, Alt(ParsersList[string]{
+		Terminated(word, Char(';')),
+		Terminated(word, Char('.')),
+	}))
+
+	tests := []struct {
+		name  string
+		input string
+		want  ParseResult[string]
+		calls int
+	}{
+		{
+			name:  "successful parse",
+			input: "hello.",
+			want:  ParseResult[string]{next: "", parsed: "hello", err: nil},
+			calls: 1,
+		},
+		{
+			name:  "cached error",
+			input: "123",
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "",
+				err: mergeErrors("123", []error{
+					wrapError(newParseError("character matching the predicate", `'1'`), "", "content of Terminated"),
+					wrapError(newParseError("character matching the predicate", `'1'`), "", "content of Terminated"),
+				}),
+			},
+			calls: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			calls = 0
+			next, parsed, err := statement(tc.input)
+			got := ParseResult[string]{next, parsed, err}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("%s: expected %+v, but got %+v", tc.name, tc.want, got)
+			}
+
+			if calls != tc.calls {
+				t.Fatalf("%s: expected %d calls, but got %d", tc.name, tc.calls, calls)
+			}
+
+			if len(table.sessions) != 0 {
+				t.Fatalf("%s: expected the cache to be discarded after parsing, but got %d caches", tc.name, len(table.sessions))
+			}
+		})
+	}
+}
+
+func TestMemoSessions(t *testing.T) {
+	table := NewMemoTable()
+	calls := 0
+	word := Memo(table, func(input string) (string, string, error) {
+		calls++
+		return StrictTakeWhile(unicode.IsLetter)(input)
+	})
+
+	t.Run("without packrat", func(t *testing.T) {
+		calls = 0
+
+		for _, input := range []string{"abc", "xyz", "xyz"} {
+			if _, parsed, err := word(input); parsed != input || err != nil {
+				t.Fatalf("expected %q, but got %q and %v", input, parsed, err)
+			}
+		}
+
+		if calls != 3 {
+			t.Fatalf("expected 3 uncached calls, but got %d", calls)
+		}
+	})
+
+	t.Run("nested packrat", func(t *testing.T) {
+		calls = 0
+		nested := Packrat(table, Peek(word))
+		statement := Packrat(table, Preceded(nested, Terminated(word, Char(';'))))
+
+		if _, parsed, err := statement("abc;"); parsed != "abc" || err != nil {
+			t.Fatalf("expected %q, but got %q and %v", "abc", parsed, err)
+		}
+
+		if calls != 1 {
+			t.Fatalf("expected the nested parse to share the cache, but got %d calls", calls)
+		}
+	})
+
+	t.Run("concurrent parses", func(t *testing.T) {
+		statement := Packrat(table, Terminated(Memo(table, StrictTakeWhile(unicode.IsLetter)), Char(';')))
+		inputs := []string{"abc;", "xyz;", "foo;", "bar;"}
+		results := make([]string, len(inputs))
+
+		var wg sync.WaitGroup
+
+		for i, input := range inputs {
+			wg.Add(1)
+
+			go func(i int, input string) {
+				defer wg.Done()
+
+				for j := 0; j < 100; j++ {
+					_, results[i], _ = statement(strings.Clone(input))
+				}
+			}(i, input)
+		}
+
+		wg.Wait()
+
+		for i, input := range inputs {
+			if results[i] != input[:3] {
+				t.Fatalf("expected %q, but got %q", input[:3], results[i])
+			}
+		}
+	})
+}
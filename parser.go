@@ -1,10 +1,5 @@
 package gom
 
-import (
-	"fmt"
-	"strings"
-)
-
 // Represents the type of parser evaluation.
 type ParserMode int
 
@@ -17,7 +12,9 @@ const (
 	STRICT
 )
 
-// Describes the signature function for parsers.
+// Describes the signature function for parsers over strings.
+//
+// It has the same shape than an [InputParser] over strings, so both can be converted into each other.
 type Parser[O any] func(input string) (string, O, error)
 
 // Takes a target rune and returns a parser which matches the first rune of the input string against the target.
@@ -25,21 +22,7 @@ type Parser[O any] func(input string) (string, O, error)
 // If it matches, returns the rest of the string, the stringified rune and a nil error.
 // Else returns empty values for the next string and matched string, and returns a fullfilled error.
 func Char(target rune) Parser[string] {
-	return func(input string) (string, string, error) {
-		if len(input) == 0 {
-			return "", "", fmt.Errorf("input string is too short for parse")
-		}
-
-		parsed := string(input[0])
-
-		if parsed != string(target) {
-			return "", "", fmt.Errorf("character does not match")
-		}
-
-		next := input[1:]
-
-		return next, parsed, nil
-	}
+	return Parser[string](TextChar[string](target))
 }
 
 // Takes a target string and returns a parser which matches the first set of characters of the input string against the target.
@@ -47,37 +30,24 @@ func Char(target rune) Parser[string] {
 // If it matches, returns the rest of the string, the matched string and a nil error.
 // Else returns empty values for the next string and matched string, and returns a fullfilled error.
 func Match(target string) Parser[string] {
-	return func(input string) (string, string, error) {
-		targetLength := len(target)
-
-		if targetLength > len(input) {
-			return "", "", fmt.Errorf("input string is too short for parse")
-		}
-
-		parsed := input[:len(target)]
-
-		if parsed != target {
-			return "", "", fmt.Errorf("target does not match")
-		}
-
-		return input[len(target):], parsed, nil
-	}
+	return Parser[string](TextMatch(target))
 }
 
-// Takes an unsigned integer and returns a parser which takes the first n characters from the beginning of the input string.
+// Takes an unsigned integer and returns a parser which takes the first n characters (runes) from the beginning of the input string.
 //
 // If it matches, returns the rest of the string, string built from the taken characters and a nil error.
 // Else returns empty values for the next string and matched string, and returns a fullfilled error.
 func Take(amount uint) Parser[string] {
-	return func(input string) (string, string, error) {
-		if amount > uint(len(input)) {
-			return "", "", fmt.Errorf("input string is too short for parse")
-		}
-
-		parsed := input[:amount]
+	return Parser[string](TextTake[string](amount))
+}
 
-		return input[amount:], parsed, nil
-	}
+// Takes an unsigned integer and returns a parser which takes the first n bytes from the beginning of the input string.
+//
+// Unlike [Take], it may split a multi-byte character.
+// If it matches, returns the rest of the string, string built from the taken bytes and a nil error.
+// Else returns empty values for the next string and matched string, and returns a fullfilled error.
+func TakeBytes(amount uint) Parser[string] {
+	return Parser[string](TextTakeBytes[string](amount))
 }
 
 // Takes a set of characters and returns a parser which tries to match the first character in the input string against one of the given characters.
@@ -85,21 +55,7 @@ func Take(amount uint) Parser[string] {
 // If it matches, returns the rest of the string, the character matched as string and a nil error.
 // Else returns empty values for the next string and matched string, and returns a fullfilled error.
 func OneOf(characters string) Parser[string] {
-	return func(input string) (string, string, error) {
-		if len(input) == 0 {
-			return "", "", fmt.Errorf("input string is too short for parse")
-		}
-
-		parsed := string(input[0])
-
-		for _, c := range characters {
-			if parsed == string(c) {
-				return input[1:], parsed, nil
-			}
-		}
-
-		return "", "", fmt.Errorf("none character match")
-	}
+	return Parser[string](TextOneOf[string](characters))
 }
 
 // Takes a set of characters and returns a parser which tries to match the first character in the input string against none of the given characters.
@@ -107,21 +63,7 @@ func OneOf(characters string) Parser[string] {
 // If it doesnt match, returns the rest of the string, the first character as string and a nil error.
 // Else returns empty values for the next string and matched string, and returns a fullfilled error.
 func NoneOf(characters string) Parser[string] {
-	return func(input string) (string, string, error) {
-		if len(input) == 0 {
-			return "", "", fmt.Errorf("input string is too short for parse")
-		}
-
-		firstChar := string(input[0])
-
-		for _, c := range characters {
-			if firstChar == string(c) {
-				return "", "", fmt.Errorf("some character match")
-			}
-		}
-
-		return input[1:], firstChar, nil
-	}
+	return Parser[string](TextNoneOf[string](characters))
 }
 
 // Takes a target and returns a parser which tries to accumulate all the characters until reach the given target.
@@ -129,26 +71,10 @@ func NoneOf(characters string) Parser[string] {
 // If it matches, returns the rest input including the target, the accumulated characters before the target occurrence as string
 // and a nil error.
 func TakeUntil(target string) Parser[string] {
-	return func(input string) (string, string, error) {
-		parsed, next, found := strings.Cut(input, target)
-
-		if !found {
-			return input, "", nil
-		}
-
-		return target + next, parsed, nil
-	}
+	return Parser[string](TextTakeUntil(target))
 }
 
 // Same parsing proccess than [TakeUntil] but in a [STRICT] mode.
 func StrictTakeUntil(target string) Parser[string] {
-	return func(input string) (string, string, error) {
-		parsed, next, found := strings.Cut(input, target)
-
-		if !found {
-			return "", "", fmt.Errorf("cannot match target")
-		}
-
-		return target + next, parsed, nil
-	}
+	return Parser[string](TextStrictTakeUntil(target))
 }
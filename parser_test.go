@@ -1,7 +1,7 @@
 package gom
 
 import (
-	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -24,7 +24,11 @@ func TestChar(t *testing.T) {
 			want: ParseResult[string]{
 				next:   "",
 				parsed: "",
-				err:    fmt.Errorf("input string is too short for parse"),
+				err: &ParseError{
+					Position: Position{Offset: 0, Line: 1, Column: 1},
+					Expected: `'K'`,
+					Found:    "end of input",
+				},
 			},
 		},
 		{
@@ -34,7 +38,21 @@ func TestChar(t *testing.T) {
 			want: ParseResult[string]{
 				next:   "",
 				parsed: "",
-				err:    fmt.Errorf("character does not match"),
+				err: &ParseError{
+					Position: Position{Offset: 0, Line: 1, Column: 1},
+					Expected: `'r'`,
+					Found:    `'A'`,
+				},
+			},
+		},
+		{
+			name:   "successful multi-byte parse",
+			input:  "éclair",
+			params: 'é',
+			want: ParseResult[string]{
+				next:   "clair",
+				parsed: "é",
+				err:    nil,
 			},
 		},
 	}
@@ -61,7 +79,11 @@ func TestMatch(t *testing.T) {
 			want: ParseResult[string]{
 				next:   "",
 				parsed: "",
-				err:    fmt.Errorf("input string is too short for parse"),
+				err: &ParseError{
+					Position: Position{Offset: 0, Line: 1, Column: 1},
+					Expected: `'Long params'`,
+					Found:    `'short'`,
+				},
 			},
 		},
 		{
@@ -71,7 +93,11 @@ func TestMatch(t *testing.T) {
 			want: ParseResult[string]{
 				next:   "",
 				parsed: "",
-				err:    fmt.Errorf("target does not match"),
+				err: &ParseError{
+					Position: Position{Offset: 0, Line: 1, Column: 1},
+					Expected: `'Your'`,
+					Found:    `'My t'`,
+				},
 			},
 		},
 	}
@@ -98,7 +124,49 @@ func TestTake(t *testing.T) {
 			want: ParseResult[string]{
 				next:   "",
 				parsed: "",
-				err:    fmt.Errorf("input string is too short for parse"),
+				err: &ParseError{
+					Position: Position{Offset: 0, Line: 1, Column: 1},
+					Expected: "10 characters",
+					Found:    `'short'`,
+				},
+			},
+		},
+		{
+			name:   "successful multi-byte parse",
+			input:  "αβγδ",
+			params: 3,
+			want: ParseResult[string]{
+				next:   "δ",
+				parsed: "αβγ",
+				err:    nil,
+			},
+		},
+		{
+			name:   "too short multi-byte input error",
+			input:  "αβ",
+			params: 3,
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "",
+				err: &ParseError{
+					Position: Position{Offset: 0, Line: 1, Column: 1},
+					Expected: "3 characters",
+					Found:    `'αβ'`,
+				},
+			},
+		},
+		{
+			name:   "too short long input error",
+			input:  strings.Repeat("a", 100),
+			params: 101,
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "",
+				err: &ParseError{
+					Position: Position{Offset: 0, Line: 1, Column: 1},
+					Expected: "101 characters",
+					Found:    `'aaaaaaaa'`,
+				},
 			},
 		},
 	}
@@ -107,6 +175,37 @@ func TestTake(t *testing.T) {
 
 }
 
+func TestTakeBytes(t *testing.T) {
+	tests := []ParserTestCase[uint, string]{
+		{
+			name:   "successful parse",
+			input:  "αβγ",
+			params: 4,
+			want: ParseResult[string]{
+				next:   "γ",
+				parsed: "αβ",
+				err:    nil,
+			},
+		},
+		{
+			name:   "too short input error",
+			input:  "αβ",
+			params: 5,
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "",
+				err: &ParseError{
+					Position: Position{Offset: 0, Line: 1, Column: 1},
+					Expected: "5 bytes",
+					Found:    `'αβ'`,
+				},
+			},
+		},
+	}
+
+	ExecParserTestCases(t, TakeBytes, tests)
+}
+
 func TestOneOf(t *testing.T) {
 	tests := []ParserTestCase[string, string]{
 		{
@@ -126,7 +225,11 @@ func TestOneOf(t *testing.T) {
 			want: ParseResult[string]{
 				next:   "",
 				parsed: "",
-				err:    fmt.Errorf("input string is too short for parse"),
+				err: &ParseError{
+					Position: Position{Offset: 0, Line: 1, Column: 1},
+					Expected: "one of 'abc'",
+					Found:    "end of input",
+				},
 			},
 		},
 		{
@@ -136,7 +239,21 @@ func TestOneOf(t *testing.T) {
 			want: ParseResult[string]{
 				next:   "",
 				parsed: "",
-				err:    fmt.Errorf("none character match"),
+				err: &ParseError{
+					Position: Position{Offset: 0, Line: 1, Column: 1},
+					Expected: "one of 'xyz'",
+					Found:    `'a'`,
+				},
+			},
+		},
+		{
+			name:   "successful multi-byte parse",
+			input:  "βeta",
+			params: "αβγ",
+			want: ParseResult[string]{
+				next:   "eta",
+				parsed: "β",
+				err:    nil,
 			},
 		},
 	}
@@ -163,7 +280,11 @@ func TestNoneOf(t *testing.T) {
 			want: ParseResult[string]{
 				next:   "",
 				parsed: "",
-				err:    fmt.Errorf("input string is too short for parse"),
+				err: &ParseError{
+					Position: Position{Offset: 0, Line: 1, Column: 1},
+					Expected: "none of 'abc'",
+					Found:    "end of input",
+				},
 			},
 		},
 		{
@@ -173,7 +294,35 @@ func TestNoneOf(t *testing.T) {
 			want: ParseResult[string]{
 				next:   "",
 				parsed: "",
-				err:    fmt.Errorf("some character match"),
+				err: &ParseError{
+					Position: Position{Offset: 0, Line: 1, Column: 1},
+					Expected: "none of 'abc'",
+					Found:    `'a'`,
+				},
+			},
+		},
+		{
+			name:   "successful multi-byte parse",
+			input:  "ñandu",
+			params: "αβγ",
+			want: ParseResult[string]{
+				next:   "andu",
+				parsed: "ñ",
+				err:    nil,
+			},
+		},
+		{
+			name:   "some multi-byte character match error",
+			input:  "γamma",
+			params: "αβγ",
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "",
+				err: &ParseError{
+					Position: Position{Offset: 0, Line: 1, Column: 1},
+					Expected: "none of 'αβγ'",
+					Found:    `'γ'`,
+				},
 			},
 		},
 	}
@@ -227,10 +376,29 @@ func TestStrictTakeUntil(t *testing.T) {
 			want: ParseResult[string]{
 				next:   "",
 				parsed: "",
-				err:    fmt.Errorf("cannot match target"),
+				err: &ParseError{
+					Position: Position{Offset: 29, Line: 1, Column: 30},
+					Expected: `'people'`,
+					Found:    "end of input",
+				},
 			},
 		},
 	}
 
 	ExecParserTestCases(t, StrictTakeUntil, tests)
 }
+
+func BenchmarkPrimitives(b *testing.B) {
+	text := strings.Repeat("lorem ipsum dolor sit amet ", 1<<10) + "end"
+
+	ExecParserBenchmarks(b, []ParserBenchmark{
+		NewParserBenchmark("Char", text, Char('l')),
+		NewParserBenchmark("Match", text, Match("lorem ipsum")),
+		NewParserBenchmark("Take", text, Take(64)),
+		NewParserBenchmark("TakeBytes", text, TakeBytes(64)),
+		NewParserBenchmark("OneOf", text, OneOf("abcdefghijklmnopqrstuvwxyz")),
+		NewParserBenchmark("NoneOf", text, NoneOf("0123456789")),
+		NewParserBenchmark("TakeUntil", text, TakeUntil("end")),
+		NewParserBenchmark("StrictTakeUntil", text, StrictTakeUntil("end")),
+	})
+}
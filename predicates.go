@@ -1,7 +1,5 @@
 package gom
 
-import "fmt"
-
 // Describes the signature for predicate function in predicate based parsers
 type Predicate func(ch rune) bool
 
@@ -14,10 +12,14 @@ const (
 )
 
 // Helper function for define the predicate evaluation based on the parser mode and compliance condition.
-func evalPredicate(input string, parserMode ParserMode, breakOn ComplianceMode, predicate Predicate) (string, error) {
-	var accumulated string
+//
+// Returns the prefix of the input whose characters were accumulated before the predicate broke.
+func evalPredicate[I Text](input I, parserMode ParserMode, breakOn ComplianceMode, predicate Predicate) (I, error) {
+	end := 0
+
+	for end < len(input) {
+		ch, size := decodeRune(input[end:])
 
-	for _, ch := range input {
 		if breakOn == COMPLY {
 			if predicate(ch) {
 				break
@@ -31,11 +33,19 @@ func evalPredicate(input string, parserMode ParserMode, breakOn ComplianceMode,
 
 		}
 
-		accumulated += string(ch)
+		end += size
 	}
 
+	accumulated := input[:end]
+
 	if parserMode == STRICT && len(accumulated) == 0 {
-		return "", fmt.Errorf("at least one character should match with the predicate")
+		expected := "character matching the predicate"
+
+		if breakOn == COMPLY {
+			expected = "character not matching the predicate"
+		}
+
+		return accumulated, newParseError(expected, describeText(input, 1))
 	}
 
 	return accumulated, nil
@@ -45,50 +55,26 @@ func evalPredicate(input string, parserMode ParserMode, breakOn ComplianceMode,
 //
 // Returns the rest of the string, the accumulated characters which complied the predicate as string and a nil error.
 func TakeWhile(predicate Predicate) Parser[string] {
-	return func(input string) (string, string, error) {
-		parsed, _ := evalPredicate(input, FLEX, UNCOMPLY, predicate)
-
-		return input[len(parsed):], parsed, nil
-	}
+	return Parser[string](TextTakeWhile[string](predicate))
 }
 
 // Takes a predicate function and applies it sequentially over each character of the input string until evaluates to false.
 //
 // Returns the rest of the string, the accumulated characters which complied the predicate as string and a nil error.
 func StrictTakeWhile(predicate Predicate) Parser[string] {
-	return func(input string) (string, string, error) {
-		parsed, err := evalPredicate(input, STRICT, UNCOMPLY, predicate)
-
-		if err != nil {
-			return "", "", err
-		}
-
-		return input[len(parsed):], parsed, nil
-	}
+	return Parser[string](TextStrictTakeWhile[string](predicate))
 }
 
 // Takes a predicate function and applies it sequentially over each character of the input string until evaluates to true.
 //
 // Returns the rest of the string, the accumulated characters which not complied the predacte as string and a nil error.
 func TakeTill(predicate Predicate) Parser[string] {
-	return func(input string) (string, string, error) {
-		parsed, _ := evalPredicate(input, FLEX, COMPLY, predicate)
-
-		return input[len(parsed):], parsed, nil
-	}
+	return Parser[string](TextTakeTill[string](predicate))
 }
 
 // Takes a predicate function and applies it sequentially over each character of the input string until evaluates to true.
 //
 // Returns the rest of the string, the accumulated characters which not complied the predicate as string and a nil error.
 func StrictTakeTill(predicate Predicate) Parser[string] {
-	return func(input string) (string, string, error) {
-		parsed, err := evalPredicate(input, STRICT, UNCOMPLY, predicate)
-
-		if err != nil {
-			return "", "", fmt.Errorf("at least one character should match with the predicate")
-		}
-
-		return input[len(parsed):], parsed, nil
-	}
+	return Parser[string](TextStrictTakeTill[string](predicate))
 }
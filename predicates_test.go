@@ -1,7 +1,7 @@
 package gom
 
 import (
-	"fmt"
+	"strings"
 	"testing"
 	"unicode"
 )
@@ -60,7 +60,11 @@ func TestStrictTakeWhile(t *testing.T) {
 			want: ParseResult[string]{
 				next:   "",
 				parsed: "",
-				err:    fmt.Errorf("at least one character should match with the predicate"),
+				err: &ParseError{
+					Position: Position{Offset: 0, Line: 1, Column: 1},
+					Expected: "character matching the predicate",
+					Found:    `'A'`,
+				},
 			},
 		},
 	}
@@ -105,7 +109,7 @@ func TestStrictTakeTill(t *testing.T) {
 			name:  "successful parse",
 			input: "\n\t\ta lot of spaces",
 			params: func(ch rune) bool {
-				return unicode.IsSpace(ch)
+				return unicode.IsLetter(ch)
 			},
 			want: ParseResult[string]{
 				next:   "a lot of spaces",
@@ -117,15 +121,54 @@ func TestStrictTakeTill(t *testing.T) {
 			name:  "predicate dont match error",
 			input: "123456789",
 			params: func(ch rune) bool {
-				return unicode.IsLetter(ch)
+				return unicode.IsDigit(ch)
 			},
 			want: ParseResult[string]{
 				next:   "",
 				parsed: "",
-				err:    fmt.Errorf("at least one character should match with the predicate"),
+				err: &ParseError{
+					Position: Position{Offset: 0, Line: 1, Column: 1},
+					Expected: "character not matching the predicate",
+					Found:    `'1'`,
+				},
 			},
 		},
 	}
 
 	ExecParserTestCases(t, StrictTakeTill, tests)
 }
+
+func TestPredicateAllocations(t *testing.T) {
+	input := strings.Repeat("abcdé", 1000) + "123"
+	parsers := map[string]Parser[string]{
+		"TakeWhile":       TakeWhile(unicode.IsLetter),
+		"StrictTakeWhile": StrictTakeWhile(unicode.IsLetter),
+		"TakeTill":        TakeTill(unicode.IsDigit),
+		"StrictTakeTill":  StrictTakeTill(unicode.IsDigit),
+	}
+
+	for name, parser := range parsers {
+		t.Run(name, func(t *testing.T) {
+			allocs := testing.AllocsPerRun(100, func() {
+				if _, _, err := parser(input); err != nil {
+					t.Fatalf("%s: unexpected error %v", name, err)
+				}
+			})
+
+			if allocs != 0 {
+				t.Fatalf("%s: expected no allocations, but got %v", name, allocs)
+			}
+		})
+	}
+}
+
+func BenchmarkPredicates(b *testing.B) {
+	letters := strings.Repeat("abcdefgh", 1<<14) + "123"
+
+	ExecParserBenchmarks(b, []ParserBenchmark{
+		NewParserBenchmark("TakeWhile", letters, TakeWhile(unicode.IsLetter)),
+		NewParserBenchmark("StrictTakeWhile", letters, StrictTakeWhile(unicode.IsLetter)),
+		NewParserBenchmark("TakeTill", letters, TakeTill(unicode.IsDigit)),
+		NewParserBenchmark("StrictTakeTill", letters, StrictTakeTill(unicode.IsDigit)),
+	})
+}
@@ -0,0 +1,52 @@
+package gom
+
+import "strings"
+
+// Collects the errors recorded by recovering parsers while parsing a source.
+//
+// Recorded errors are located relative to the beginning of the source, no matter how deep in the grammar they were found.
+type Diagnostics struct {
+	located *Located
+	errors  []*ParseError
+}
+
+// Takes the source which is going to be parsed and returns an empty diagnostics sink for it.
+func NewDiagnostics(source string) *Diagnostics {
+	return &Diagnostics{located: NewLocated(source)}
+}
+
+// Returns the recorded errors in the order they were found.
+func (d *Diagnostics) Errors() []*ParseError {
+	return d.errors
+}
+
+// Records the error returned by a parser which received the given input, which must be a suffix of the source.
+func (d *Diagnostics) record(input string, err error) {
+	d.errors = append(d.errors, wrapErrorAt(err, d.located.Position(input), ""))
+}
+
+// Takes a parser, a synchronisation token and a diagnostics sink, and returns a parser which recovers from the failures of the given parser.
+//
+// When the parser fails, unless it needs more input in streaming mode, its error is recorded in the diagnostics and the input is skipped until right after the next
+// occurrence of the token, or until the end of the input if there is none. Then returns the rest of the input, the zero
+// value as a partial result and a nil error, so repetitions like [Many] keep parsing after a broken item.
+func Recover[O any](parser Parser[O], token string, diagnostics *Diagnostics) Parser[O] {
+	return func(input string) (string, O, error) {
+		next, parsed, err := parser(input)
+
+		if err == nil || IsIncomplete(err) {
+			return next, parsed, err
+		}
+
+		diagnostics.record(input, err)
+
+		var recovered O
+		rest, _, err := StrictTakeUntil(token)(input)
+
+		if err != nil {
+			return "", recovered, nil
+		}
+
+		return strings.TrimPrefix(rest, token), recovered, nil
+	}
+}
@@ -0,0 +1,67 @@
+package gom
+
+import (
+	"reflect"
+	"testing"
+	"unicode"
+)
+
+func TestRecover(t *testing.T) {
+	statement := Terminated(Preceded(Match("let "), Cut(StrictTakeWhile(unicode.IsLetter))), Char(';'))
+
+	tests := []struct {
+		name  string
+		input string
+		want  ParseResult[[]string]
+		// Positions of the recorded errors relative to the whole input.
+		diagnostics []Position
+	}{
+		{
+			name:  "successful parse",
+			input: "let a;let b;",
+			want: ParseResult[[]string]{
+				next:   "",
+				parsed: []string{"a", "b"},
+				err:    nil,
+			},
+			diagnostics: nil,
+		},
+		{
+			name:  "recover from several errors",
+			input: "let a;\nlet 1;\nlet b;\nlet ?",
+			want: ParseResult[[]string]{
+				next:   "",
+				parsed: []string{"a", "", "b", ""},
+				err:    nil,
+			},
+			diagnostics: []Position{
+				{Offset: 11, Line: 2, Column: 5},
+				{Offset: 25, Line: 4, Column: 5},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			diagnostics := NewDiagnostics(tc.input)
+			parser := Many(Terminated(Recover(statement, ";", diagnostics), TakeWhile(unicode.IsSpace)))
+
+			next, parsed, err := parser(tc.input)
+			got := ParseResult[[]string]{next, parsed, err}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("%s: expected %+v, but got %+v", tc.name, tc.want, got)
+			}
+
+			var positions []Position
+
+			for _, diagnostic := range diagnostics.Errors() {
+				positions = append(positions, diagnostic.Position)
+			}
+
+			if !reflect.DeepEqual(positions, tc.diagnostics) {
+				t.Fatalf("%s: expected diagnostics at %+v, but got %+v", tc.name, tc.diagnostics, positions)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package gom
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Represents the output style of rendered diagnostics.
+type RenderMode int
+
+//   - PLAIN: plain text diagnostics, suitable for logs and files.
+//
+//   - COLORED: diagnostics highlighted with ANSI escape codes, suitable for terminals.
+
+const (
+	PLAIN RenderMode = iota
+	COLORED
+)
+
+const (
+	ansiReset = "\x1b[0m"
+	ansiBold  = "\x1b[1m"
+	ansiRed   = "\x1b[31m"
+	ansiBlue  = "\x1b[34m"
+)
+
+// Takes the original input and an error returned by a parser over it, and builds a human readable diagnostic.
+//
+// The diagnostic shows the error description, the source line where the failure happened with a caret under
+// the failing column, and the combinators which led to the failure. Errors which are not parse errors are rendered
+// as a single line, and a nil error renders as an empty string.
+func Render(input string, err error, mode RenderMode) string {
+	if err == nil {
+		return ""
+	}
+
+	paint := func(style, text string) string {
+		if mode != COLORED {
+			return text
+		}
+
+		return style + text + ansiReset
+	}
+
+	var parseErr *ParseError
+
+	if !errors.As(err, &parseErr) {
+		return paint(ansiBold+ansiRed, "error") + ": " + err.Error() + "\n"
+	}
+
+	offset := min(max(parseErr.Offset, 0), len(input))
+	lineStart := strings.LastIndexByte(input[:offset], '\n') + 1
+	lineEnd := strings.IndexByte(input[offset:], '\n')
+
+	if lineEnd < 0 {
+		lineEnd = len(input)
+	} else {
+		lineEnd += offset
+	}
+
+	line := strings.TrimSuffix(input[lineStart:lineEnd], "\r")
+	lineNumber := strconv.Itoa(parseErr.Line)
+	gutter := strings.Repeat(" ", len(lineNumber))
+
+	// Keep tabs in the caret padding so the caret stays aligned with the source line.
+	padding := strings.Map(func(ch rune) rune {
+		if ch == '\t' {
+			return ch
+		}
+
+		return ' '
+	}, input[lineStart:offset])
+
+	var builder strings.Builder
+
+	fmt.Fprintf(&builder, "%s: %s\n", paint(ansiBold+ansiRed, "error"), parseErr.describe())
+	fmt.Fprintf(&builder, "%s%s line %d, column %d\n", gutter, paint(ansiBlue, "-->"), parseErr.Line, parseErr.Column)
+	fmt.Fprintf(&builder, "%s %s\n", gutter, paint(ansiBlue, "|"))
+	fmt.Fprintf(&builder, "%s %s %s\n", paint(ansiBlue, lineNumber), paint(ansiBlue, "|"), line)
+	fmt.Fprintf(&builder, "%s %s %s%s\n", gutter, paint(ansiBlue, "|"), padding, paint(ansiBold+ansiRed, "^"))
+
+	for _, context := range parseErr.Context {
+		fmt.Fprintf(&builder, "%s %s in %s\n", gutter, paint(ansiBlue, "="), context)
+	}
+
+	return builder.String()
+}
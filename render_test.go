@@ -0,0 +1,103 @@
+package gom
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRender(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		err   error
+		mode  RenderMode
+		want  string
+	}{
+		{
+			name:  "single line",
+			input: "foobaz",
+			err: &ParseError{
+				Position: Position{Offset: 3, Line: 1, Column: 4},
+				Expected: `'bar'`,
+				Found:    `'baz'`,
+				Context:  []string{"second parser of Pair"},
+			},
+			mode: PLAIN,
+			want: "error: expected 'bar' but found 'baz'\n" +
+				" --> line 1, column 4\n" +
+				"  |\n" +
+				"1 | foobaz\n" +
+				"  |    ^\n" +
+				"  = in second parser of Pair\n",
+		},
+		{
+			name:  "multiple lines with tabs",
+			input: "first\n\tlet x = ;\nthird",
+			err: &ParseError{
+				Position: Position{Offset: 15, Line: 2, Column: 10},
+				Expected: "expression",
+				Found:    `';'`,
+			},
+			mode: PLAIN,
+			want: "error: expected expression but found ';'\n" +
+				" --> line 2, column 10\n" +
+				"  |\n" +
+				"2 | \tlet x = ;\n" +
+				"  | \t        ^\n",
+		},
+		{
+			name:  "end of input",
+			input: "abc",
+			err: &ParseError{
+				Position: Position{Offset: 3, Line: 1, Column: 4},
+				Expected: `'d'`,
+				Found:    "end of input",
+			},
+			mode: PLAIN,
+			want: "error: expected 'd' but found end of input\n" +
+				" --> line 1, column 4\n" +
+				"  |\n" +
+				"1 | abc\n" +
+				"  |    ^\n",
+		},
+		{
+			name:  "colored",
+			input: "x",
+			err: &ParseError{
+				Position: inputStart,
+				Expected: `'y'`,
+				Found:    `'x'`,
+			},
+			mode: COLORED,
+			want: "\x1b[1m\x1b[31merror\x1b[0m: expected 'y' but found 'x'\n" +
+				" \x1b[34m-->\x1b[0m line 1, column 1\n" +
+				"  \x1b[34m|\x1b[0m\n" +
+				"\x1b[34m1\x1b[0m \x1b[34m|\x1b[0m x\n" +
+				"  \x1b[34m|\x1b[0m \x1b[1m\x1b[31m^\x1b[0m\n",
+		},
+		{
+			name:  "not a parse error",
+			input: "abc",
+			err:   fmt.Errorf("custom failure"),
+			mode:  PLAIN,
+			want:  "error: custom failure\n",
+		},
+		{
+			name:  "nil error",
+			input: "abc",
+			err:   nil,
+			mode:  PLAIN,
+			want:  "",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := Render(tc.input, tc.err, tc.mode)
+
+			if got != tc.want {
+				t.Fatalf("%s: expected\n%q\nbut got\n%q", tc.name, tc.want, got)
+			}
+		})
+	}
+}
@@ -2,37 +2,67 @@ package gom
 
 import "fmt"
 
-func evalRepetition[O any](input string, parser Parser[O], parserMode ParserMode) (string, []O, error) {
-	accumulated := []O{}
+// Builds the error returned when the parser of a repetition succeeds without consuming the input left after the given text.
+//
+// The error is not fatal, so combinators like [Alt] can still backtrack over the repetition.
+func noProgressError(consumed string, context string) *ParseError {
+	return wrapError(ErrNoProgress, consumed, context)
+}
+
+// Helper function for define the repetition evaluation based on the parser mode, folding every matched item into the accumulator.
+func evalRepetition[O, R any](input string, parser Parser[O], parserMode ParserMode, name string, accumulator R, fold func(R, O) R) (string, R, error) {
+	initial := accumulator
 	next := input
+	matches := 0
+
+	var err error
 
-	for {
+	for len(next) > 0 {
 		n, p, e := parser(next)
 
-		if e != nil || len(next) == 0 {
+		if mustPropagate(e) {
+			return "", initial, wrapError(e, consumedText(input, next), fmt.Sprintf("item %d of %s", matches+1, name))
+		}
+
+		if e != nil {
+			err = e
 			break
 		}
 
-		accumulated = append(accumulated, p)
+		if len(n) == len(next) {
+			return "", initial, noProgressError(consumedText(input, next), fmt.Sprintf("item %d of %s", matches+1, name))
+		}
+
+		accumulator = fold(accumulator, p)
+		matches++
 		next = n
 	}
 
-	if parserMode == STRICT && len(accumulated) == 0 {
-		return "", accumulated, fmt.Errorf("parser should match at least one time")
+	if parserMode == STRICT && matches == 0 {
+		if err == nil {
+			return "", initial, newParseError("at least one match", describeInput(input, 1))
+		}
+
+		return "", initial, wrapError(err, "", "item 1 of "+name)
 	}
 
-	return next, accumulated, nil
+	return next, accumulator, nil
+}
+
+// Folds an item into a list of items.
+func appendItem[O any](items []O, item O) []O {
+	return append(items, item)
 }
 
 func Many[O any](parser Parser[O]) Parser[[]O] {
 	return func(input string) (string, []O, error) {
-		return evalRepetition(input, parser, FLEX)
+		return evalRepetition(input, parser, FLEX, "Many", []O{}, appendItem)
 	}
 }
 
 func StrictMany[O any](parser Parser[O]) Parser[[]O] {
 	return func(input string) (string, []O, error) {
-		return evalRepetition(input, parser, STRICT)
+		return evalRepetition(input, parser, STRICT, "StrictMany", []O{}, appendItem)
 	}
 }
 
@@ -45,7 +75,7 @@ func Count[O any](parser Parser[O], times uint) Parser[[]O] {
 			n, p, e := parser(next)
 
 			if e != nil {
-				return "", []O{}, fmt.Errorf("cannot execute parser %v times", times)
+				return "", []O{}, wrapError(e, consumedText(input, next), fmt.Sprintf("item %d of Count", i+1))
 			}
 
 			accumulated = append(accumulated, p)
@@ -55,3 +85,195 @@ func Count[O any](parser Parser[O], times uint) Parser[[]O] {
 		return next, accumulated, nil
 	}
 }
+
+// Takes a minimum and a maximum amount of matches and a parser, and returns a parser which matches it between minimum and maximum times.
+//
+// Stops after maximum matches. If the parser fails before matching minimum times, returns empty values for the next string and
+// matched items, and returns its error wrapped with the failing item.
+//
+// If minimum is greater than maximum, returns empty values and a fatal error wrapping [ErrInvalidBounds] for any input.
+func ManyMN[O any](minimum, maximum uint, parser Parser[O]) Parser[[]O] {
+	return func(input string) (string, []O, error) {
+		if minimum > maximum {
+			err := wrapError(fmt.Errorf("%w: %d and %d", ErrInvalidBounds, minimum, maximum), "", "ManyMN")
+			err.Fatal = true
+
+			return "", []O{}, err
+		}
+
+		accumulated := []O{}
+		next := input
+
+		for uint(len(accumulated)) < maximum {
+			// Like the other repetitions, stop at the end of the input once the minimum is reached.
+			if len(next) == 0 && uint(len(accumulated)) >= minimum {
+				break
+			}
+
+			n, p, e := parser(next)
+
+			if e != nil && (uint(len(accumulated)) < minimum || mustPropagate(e)) {
+				return "", []O{}, wrapError(e, consumedText(input, next), fmt.Sprintf("item %d of ManyMN", len(accumulated)+1))
+			}
+
+			if e != nil {
+				break
+			}
+
+			if len(n) == len(next) {
+				return "", []O{}, noProgressError(consumedText(input, next), fmt.Sprintf("item %d of ManyMN", len(accumulated)+1))
+			}
+
+			accumulated = append(accumulated, p)
+			next = n
+		}
+
+		return next, accumulated, nil
+	}
+}
+
+// Takes a parser and a terminator parser, and returns a parser which matches the parser until the terminator matches.
+//
+// Returns the matched items along with the terminator output. If neither the terminator nor the parser match,
+// returns empty values for the next string and result, and returns both errors merged and wrapped with the failing item.
+func ManyTill[O, T any](parser Parser[O], terminator Parser[T]) Parser[PairResult[[]O, T]] {
+	return func(input string) (string, PairResult[[]O, T], error) {
+		result := PairResult[[]O, T]{First: []O{}}
+		next := input
+
+		for {
+			rest, terminated, err := terminator(next)
+
+			if err == nil {
+				result.Second = terminated
+				return rest, result, nil
+			}
+
+			context := fmt.Sprintf("item %d of ManyTill", len(result.First)+1)
+
+			if mustPropagate(err) {
+				return "", PairResult[[]O, T]{First: []O{}}, wrapError(err, consumedText(input, next), context)
+			}
+
+			rest, parsed, itemErr := parser(next)
+
+			if mustPropagate(itemErr) {
+				return "", PairResult[[]O, T]{First: []O{}}, wrapError(itemErr, consumedText(input, next), context)
+			}
+
+			if itemErr != nil {
+				return "", PairResult[[]O, T]{First: []O{}}, wrapError(mergeErrors(next, []error{err, itemErr}), consumedText(input, next), context)
+			}
+
+			if len(rest) == len(next) {
+				return "", PairResult[[]O, T]{First: []O{}}, noProgressError(consumedText(input, next), context)
+			}
+
+			result.First = append(result.First, parsed)
+			next = rest
+		}
+	}
+}
+
+// Takes a parser, an init function and a fold function, and returns a parser which matches the parser zero or more times,
+// reducing the matched items into a single value without collecting them.
+//
+// The init function builds the initial value on every parse, and the fold function combines it with each matched item.
+func FoldMany[O, R any](parser Parser[O], init func() R, fold func(R, O) R) Parser[R] {
+	return func(input string) (string, R, error) {
+		next, folded, err := evalRepetition(input, parser, FLEX, "FoldMany", init(), fold)
+
+		if err != nil {
+			var empty R
+			return "", empty, err
+		}
+
+		return next, folded, nil
+	}
+}
+
+// Represents whether a separated list accepts a separator after its last item.
+type TrailingMode int
+
+//   - DENY_TRAILING: a separator after the last item is not consumed, so it is left in the rest of the input.
+//
+//   - ALLOW_TRAILING: a separator after the last item is consumed along with the list.
+
+const (
+	DENY_TRAILING TrailingMode = iota
+	ALLOW_TRAILING
+)
+
+// Helper function for define the separated list evaluation based on the parser mode and trailing separator condition.
+func evalSeparatedList[T, O any](input string, parser Parser[O], separator Parser[T], parserMode ParserMode, trailing TrailingMode) (string, []O, error) {
+	accumulated := []O{}
+	name := "SeparatedList0"
+
+	if parserMode == STRICT {
+		name = "SeparatedList1"
+	}
+
+	next, parsed, err := parser(input)
+
+	if err != nil {
+		if parserMode == STRICT || mustPropagate(err) {
+			return "", []O{}, wrapError(err, "", "item 1 of "+name)
+		}
+
+		return input, accumulated, nil
+	}
+
+	accumulated = append(accumulated, parsed)
+
+	for len(next) > 0 {
+		rest, _, err := separator(next)
+
+		if mustPropagate(err) {
+			return "", []O{}, wrapError(err, consumedText(input, next), fmt.Sprintf("separator %d of %s", len(accumulated), name))
+		}
+
+		if err != nil {
+			break
+		}
+
+		n, p, err := parser(rest)
+
+		if mustPropagate(err) {
+			return "", []O{}, wrapError(err, consumedText(input, rest), fmt.Sprintf("item %d of %s", len(accumulated)+1, name))
+		}
+
+		if err != nil {
+			if trailing == ALLOW_TRAILING {
+				next = rest
+			}
+
+			break
+		}
+
+		if len(n) == len(next) {
+			return "", []O{}, noProgressError(consumedText(input, next), fmt.Sprintf("item %d of %s", len(accumulated)+1, name))
+		}
+
+		accumulated = append(accumulated, p)
+		next = n
+	}
+
+	return next, accumulated, nil
+}
+
+// Takes a parser, a separator parser and a trailing mode, and returns a parser which matches zero or more items separated by the separator.
+//
+// If no item matches, returns the whole input and an empty list with a nil error.
+// A separator which is not followed by an item is consumed only when the trailing mode is [ALLOW_TRAILING].
+func SeparatedList0[T, O any](parser Parser[O], separator Parser[T], trailing TrailingMode) Parser[[]O] {
+	return func(input string) (string, []O, error) {
+		return evalSeparatedList(input, parser, separator, FLEX, trailing)
+	}
+}
+
+// Same parsing process than [SeparatedList0] but fails if the first item does not match.
+func SeparatedList1[T, O any](parser Parser[O], separator Parser[T], trailing TrailingMode) Parser[[]O] {
+	return func(input string) (string, []O, error) {
+		return evalSeparatedList(input, parser, separator, STRICT, trailing)
+	}
+}
@@ -1,8 +1,9 @@
 package gom
 
 import (
-	"fmt"
+	"errors"
 	"reflect"
+	"strings"
 	"testing"
 	"unicode"
 )
@@ -57,7 +58,17 @@ func TestStrictMany(t *testing.T) {
 			want: ParseResult[[]string]{
 				next:   "",
 				parsed: []string{},
-				err:    fmt.Errorf("parser should match at least one time"),
+				err: &ParseError{
+					Position: Position{Offset: 0, Line: 1, Column: 1},
+					Expected: "character matching the predicate",
+					Found:    `'>'`,
+					Context:  []string{"item 1 of StrictMany"},
+					Err: &ParseError{
+						Position: inputStart,
+						Expected: "character matching the predicate",
+						Found:    `'>'`,
+					},
+				},
 			},
 		},
 	}
@@ -102,7 +113,17 @@ func TestCount(t *testing.T) {
 			want: ParseResult[[]string]{
 				next:   "",
 				parsed: []string{},
-				err:    fmt.Errorf("cannot execute parser %v times", 2),
+				err: &ParseError{
+					Position: Position{Offset: 0, Line: 1, Column: 1},
+					Expected: `'.'`,
+					Found:    `'n'`,
+					Context:  []string{"item 1 of Count"},
+					Err: &ParseError{
+						Position: inputStart,
+						Expected: `'.'`,
+						Found:    `'n'`,
+					},
+				},
 			},
 		},
 	}
@@ -118,3 +139,363 @@ func TestCount(t *testing.T) {
 		})
 	}
 }
+
+func TestSeparatedList(t *testing.T) {
+	type SeparatedListTestCase struct {
+		name   string
+		input  string
+		parser Parser[[]string]
+		want   ParseResult[[]string]
+	}
+
+	item := StrictTakeWhile(unicode.IsLetter)
+
+	tests := []SeparatedListTestCase{
+		{
+			name:   "successful parse",
+			input:  "a,bc,d;",
+			parser: SeparatedList0(item, Char(','), DENY_TRAILING),
+			want: ParseResult[[]string]{
+				next:   ";",
+				parsed: []string{"a", "bc", "d"},
+				err:    nil,
+			},
+		},
+		{
+			name:   "successful empty parse",
+			input:  "123",
+			parser: SeparatedList0(item, Char(','), DENY_TRAILING),
+			want: ParseResult[[]string]{
+				next:   "123",
+				parsed: []string{},
+				err:    nil,
+			},
+		},
+		{
+			name:   "trailing separator denied",
+			input:  "a,b,;",
+			parser: SeparatedList0(item, Char(','), DENY_TRAILING),
+			want: ParseResult[[]string]{
+				next:   ",;",
+				parsed: []string{"a", "b"},
+				err:    nil,
+			},
+		},
+		{
+			name:   "trailing separator allowed",
+			input:  "a,b,;",
+			parser: SeparatedList1(item, Char(','), ALLOW_TRAILING),
+			want: ParseResult[[]string]{
+				next:   ";",
+				parsed: []string{"a", "b"},
+				err:    nil,
+			},
+		},
+		{
+			name:   "first item fail error",
+			input:  "123",
+			parser: SeparatedList1(item, Char(','), DENY_TRAILING),
+			want: ParseResult[[]string]{
+				next:   "",
+				parsed: []string{},
+				err: &ParseError{
+					Position: inputStart,
+					Expected: "character matching the predicate",
+					Found:    `'1'`,
+					Context:  []string{"item 1 of SeparatedList1"},
+					Err: &ParseError{
+						Position: inputStart,
+						Expected: "character matching the predicate",
+						Found:    `'1'`,
+					},
+				},
+			},
+		},
+		{
+			name:   "fatal item error",
+			input:  "a,1",
+			parser: SeparatedList0(Cut(item), Char(','), ALLOW_TRAILING),
+			want: ParseResult[[]string]{
+				next:   "",
+				parsed: []string{},
+				err: &ParseError{
+					Position: Position{Offset: 2, Line: 1, Column: 3},
+					Expected: "character matching the predicate",
+					Found:    `'1'`,
+					Context:  []string{"item 2 of SeparatedList0"},
+					Fatal:    true,
+					Err: &ParseError{
+						Position: inputStart,
+						Expected: "character matching the predicate",
+						Found:    `'1'`,
+						Fatal:    true,
+						Err: &ParseError{
+							Position: inputStart,
+							Expected: "character matching the predicate",
+							Found:    `'1'`,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			next, parsed, err := tc.parser(tc.input)
+			got := ParseResult[[]string]{next, parsed, err}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("%s: expected %+v, but got %+v", tc.name, tc.want, got)
+			}
+		})
+	}
+}
+
+func TestManyMN(t *testing.T) {
+	tests := []ParserTestCase[Parser[string], []string]{
+		{
+			name:   "successful parse",
+			input:  "aaaab",
+			params: Char('a'),
+			want: ParseResult[[]string]{
+				next:   "ab",
+				parsed: []string{"a", "a", "a"},
+				err:    nil,
+			},
+		},
+		{
+			name:   "successful parse between bounds",
+			input:  "aab",
+			params: Char('a'),
+			want: ParseResult[[]string]{
+				next:   "b",
+				parsed: []string{"a", "a"},
+				err:    nil,
+			},
+		},
+		{
+			name:   "not enough matches error",
+			input:  "ab",
+			params: Char('a'),
+			want: ParseResult[[]string]{
+				next:   "",
+				parsed: []string{},
+				err: &ParseError{
+					Position: Position{Offset: 1, Line: 1, Column: 2},
+					Expected: `'a'`,
+					Found:    `'b'`,
+					Context:  []string{"item 2 of ManyMN"},
+					Err: &ParseError{
+						Position: inputStart,
+						Expected: `'a'`,
+						Found:    `'b'`,
+					},
+				},
+			},
+		},
+	}
+
+	manyMN := func(parser Parser[string]) Parser[[]string] {
+		return ManyMN(2, 3, parser)
+	}
+
+	ExecParserTestCases(t, manyMN, tests)
+}
+
+func TestManyMNEndOfInput(t *testing.T) {
+	tests := []ParserTestCase[uint, []string]{
+		{
+			name:   "successful empty parse",
+			input:  "",
+			params: 0,
+			want:   ParseResult[[]string]{next: "", parsed: []string{}, err: nil},
+		},
+		{
+			name:   "successful parse up to the end of the input",
+			input:  "ab",
+			params: 1,
+			want:   ParseResult[[]string]{next: "", parsed: []string{"ab"}, err: nil},
+		},
+	}
+
+	// The parser succeeds without consuming an empty input, so it must not run there once the minimum is reached.
+	manyMN := func(minimum uint) Parser[[]string] {
+		return ManyMN(minimum, 3, TakeWhile(unicode.IsLetter))
+	}
+
+	ExecParserTestCases(t, manyMN, tests)
+}
+
+func TestManyMNInvalidBounds(t *testing.T) {
+	_, parsed, err := ManyMN(3, 1, Char('a'))("aaa")
+
+	if !errors.Is(err, ErrInvalidBounds) || !IsFatal(err) || len(parsed) != 0 {
+		t.Fatalf("expected a fatal error wrapping %v, but got %v and %q", ErrInvalidBounds, err, parsed)
+	}
+}
+
+func TestManyTill(t *testing.T) {
+	tests := []ParserTestCase[Parser[string], PairResult[[]string, string]]{
+		{
+			name:   "successful parse",
+			input:  "ab;c",
+			params: Char(';'),
+			want: ParseResult[PairResult[[]string, string]]{
+				next: "c",
+				parsed: PairResult[[]string, string]{
+					First:  []string{"ab"},
+					Second: ";",
+				},
+				err: nil,
+			},
+		},
+		{
+			name:   "item and terminator fail error",
+			input:  "a1;",
+			params: Char(';'),
+			want: ParseResult[PairResult[[]string, string]]{
+				next:   "",
+				parsed: PairResult[[]string, string]{First: []string{}},
+				err: &ParseError{
+					Position: Position{Offset: 1, Line: 1, Column: 2},
+					Expected: `';' or character matching the predicate`,
+					Found:    `'1'`,
+					Context:  []string{"item 2 of ManyTill"},
+					Err: &ParseError{
+						Position: inputStart,
+						Expected: `';' or character matching the predicate`,
+						Found:    `'1'`,
+						Err: errors.Join(
+							newParseError(`';'`, `'1'`),
+							newParseError("character matching the predicate", `'1'`),
+						),
+					},
+				},
+			},
+		},
+	}
+
+	manyTill := func(terminator Parser[string]) Parser[PairResult[[]string, string]] {
+		return ManyTill(StrictTakeWhile(unicode.IsLetter), terminator)
+	}
+
+	ExecParserTestCases(t, manyTill, tests)
+}
+
+func TestFoldMany(t *testing.T) {
+	tests := []ParserTestCase[Parser[string], int]{
+		{
+			name:   "successful parse",
+			input:  "1,22,333;",
+			params: Terminated(StrictTakeWhile(unicode.IsDigit), Char(',')),
+			want: ParseResult[int]{
+				next:   "333;",
+				parsed: 3,
+				err:    nil,
+			},
+		},
+		{
+			name:   "successful empty parse",
+			input:  ";",
+			params: Terminated(StrictTakeWhile(unicode.IsDigit), Char(',')),
+			want: ParseResult[int]{
+				next:   ";",
+				parsed: 0,
+				err:    nil,
+			},
+		},
+	}
+
+	totalLength := func(parser Parser[string]) Parser[int] {
+		return FoldMany(parser, func() int { return 0 }, func(total int, item string) int {
+			return total + len(item)
+		})
+	}
+
+	ExecParserTestCases(t, totalLength, tests)
+}
+
+func TestNoProgress(t *testing.T) {
+	tests := []struct {
+		name   string
+		input  string
+		parser Parser[[]string]
+		want   ParseResult[[]string]
+	}{
+		{
+			name:   "many",
+			input:  "12abc",
+			parser: Many(TakeWhile(unicode.IsDigit)),
+			want: ParseResult[[]string]{
+				next:   "",
+				parsed: []string{},
+				err: &ParseError{
+					Position: Position{Offset: 2, Line: 1, Column: 3},
+					Context:  []string{"item 2 of Many"},
+					Err:      ErrNoProgress,
+				},
+			},
+		},
+		{
+			name:   "separated list",
+			input:  "abc",
+			parser: SeparatedList0(TakeWhile(unicode.IsDigit), Opt(Char(',')), DENY_TRAILING),
+			want: ParseResult[[]string]{
+				next:   "",
+				parsed: []string{},
+				err: &ParseError{
+					Position: inputStart,
+					Context:  []string{"item 2 of SeparatedList0"},
+					Err:      ErrNoProgress,
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			next, parsed, err := tc.parser(tc.input)
+			got := ParseResult[[]string]{next, parsed, err}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("%s: expected %+v, but got %+v", tc.name, tc.want, got)
+			}
+
+			if !errors.Is(err, ErrNoProgress) {
+				t.Fatalf("%s: expected %v to wrap ErrNoProgress", tc.name, err)
+			}
+		})
+	}
+}
+
+func TestNoProgressBacktracking(t *testing.T) {
+	parser := Alt(ParsersList[[]string]{
+		Many(TakeWhile(unicode.IsDigit)),
+		Map(Match("abc"), func(parsed string) []string { return []string{parsed} }),
+	})
+
+	next, parsed, err := parser("abc")
+	got := ParseResult[[]string]{next, parsed, err}
+	want := ParseResult[[]string]{next: "", parsed: []string{"abc"}, err: nil}
+
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %+v, but got %+v", want, got)
+	}
+}
+
+func BenchmarkRepetitions(b *testing.B) {
+	items := strings.Repeat("item,", 1<<10) + "end"
+	item := Terminated(StrictTakeWhile(unicode.IsLetter), Char(','))
+
+	ExecParserBenchmarks(b, []ParserBenchmark{
+		NewParserBenchmark("Many", items, Many(item)),
+		NewParserBenchmark("StrictMany", items, StrictMany(item)),
+		NewParserBenchmark("Count", items, Count(item, 1<<10)),
+		NewParserBenchmark("ManyMN", items, ManyMN(1, 1<<10, item)),
+		NewParserBenchmark("ManyTill", items, ManyTill(item, Match("end"))),
+		NewParserBenchmark("FoldMany", items, FoldMany(item, func() int { return 0 }, func(n int, _ string) int { return n + 1 })),
+		NewParserBenchmark("SeparatedList0", items, SeparatedList0(StrictTakeWhile(unicode.IsLetter), Char(','), DENY_TRAILING)),
+		NewParserBenchmark("SeparatedList1", items, SeparatedList1(StrictTakeWhile(unicode.IsLetter), Char(','), ALLOW_TRAILING)),
+	})
+}
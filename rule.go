@@ -0,0 +1,133 @@
+package gom
+
+import (
+	"errors"
+	"sync"
+)
+
+// Reported by a [Rule] which is used before being defined.
+var ErrUndefinedRule = errors.New("rule used before being defined")
+
+// Cause of the failure of a left recursive call before the rule has matched its base case.
+var errLeftRecursion = errors.New("left recursion without a base case")
+
+// Takes a function which builds a parser, and returns a parser which builds it the first time it runs and reuses it afterwards.
+//
+// Allows recursive grammars to refer to parsers which are not built yet, as long as they are built before the first parse.
+func Lazy[O any](build func() Parser[O]) Parser[O] {
+	var once sync.Once
+	var parser Parser[O]
+
+	return func(input string) (string, O, error) {
+		once.Do(func() {
+			parser = build()
+		})
+
+		return parser(input)
+	}
+}
+
+// Represents a parser which can be declared before its definition, so grammars can refer to it recursively.
+//
+// The [Rule.Parse] method value is a [Parser], and can be passed to any combinator before the rule is defined.
+// Rules must be defined before parsing starts.
+type Rule[O any] struct {
+	parser Parser[O]
+}
+
+// Returns an undefined rule.
+func NewRule[O any]() *Rule[O] {
+	return &Rule[O]{}
+}
+
+// Sets the parser which the rule runs.
+func (r *Rule[O]) Define(parser Parser[O]) {
+	r.parser = parser
+}
+
+// Runs the parser of the rule over the input.
+//
+// If the rule is not defined yet, returns empty values and a fatal error wrapping [ErrUndefinedRule].
+func (r *Rule[O]) Parse(input string) (string, O, error) {
+	if r.parser == nil {
+		var empty O
+		err := wrapError(ErrUndefinedRule, "", "")
+		err.Fatal = true
+
+		return "", empty, err
+	}
+
+	return r.parser(input)
+}
+
+// Represents a [Rule] which may refer to itself at the beginning of its definition, like "expr := expr '-' term | term".
+//
+// Supports direct left recursion by growing a seed: the recursive call first fails, so the rule matches its base case,
+// and then the rule runs again reusing the previous match as the result of the recursive call, for as long as the match grows.
+// Results are cached in a memo table: the outermost call of Parse over a source starts its own cache, which is discarded
+// when it returns, so every parse starts afresh. Rules called inside a [Packrat] parse share its cache instead.
+// The results which [Memo] parsers cache while the seed grows depend on the seed, so they are discarded every time it grows.
+type RecursiveRule[O any] struct {
+	table  *MemoTable
+	id     uint64
+	parser Parser[O]
+}
+
+// Takes the memo table of the parse and returns an undefined recursive rule.
+func NewRecursiveRule[O any](table *MemoTable) *RecursiveRule[O] {
+	return &RecursiveRule[O]{table: table, id: memoIDs.Add(1)}
+}
+
+// Sets the parser which the rule runs.
+func (r *RecursiveRule[O]) Define(parser Parser[O]) {
+	r.parser = parser
+}
+
+// Runs the parser of the rule over the input, growing its match while the rule refers to itself.
+//
+// If the rule is not defined yet, returns empty values and a fatal error wrapping [ErrUndefinedRule].
+func (r *RecursiveRule[O]) Parse(input string) (string, O, error) {
+	var empty O
+
+	if r.parser == nil {
+		err := wrapError(ErrUndefinedRule, "", "")
+		err.Fatal = true
+
+		return "", empty, err
+	}
+
+	defer r.table.begin(input)()
+
+	if entry, ok := r.table.lookup(r.id, input); ok {
+		parsed, _ := entry.parsed.(O)
+		return entry.next, parsed, entry.err
+	}
+
+	// The seed makes the recursive call fail, so the first run can only match the base case of the rule.
+	seed := memoEntry{err: &ParseError{Position: inputStart, Err: errLeftRecursion}}
+	r.table.store(r.id, input, seed)
+
+	for grown := false; ; grown = true {
+		mark := r.table.mark(input)
+		next, parsed, err := r.parser(input)
+
+		if err != nil && (!grown || mustPropagate(err)) {
+			r.table.store(r.id, input, memoEntry{err: err})
+			return "", empty, err
+		}
+
+		if err != nil || (grown && len(next) >= len(seed.next)) {
+			break
+		}
+
+		// The results cached during this run were computed with the previous seed.
+		r.table.rollback(input, mark)
+
+		seed = memoEntry{next: next, parsed: parsed}
+		r.table.store(r.id, input, seed)
+	}
+
+	parsed, _ := seed.parsed.(O)
+
+	return seed.next, parsed, nil
+}
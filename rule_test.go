@@ -0,0 +1,194 @@
+package gom
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"testing"
+	"unicode"
+)
+
+// Counts the depth of nested parentheses, like "(())".
+func nestingDepth() Parser[int] {
+	var depth Parser[int]
+
+	depth = Alt(
+		ParsersList[int]{
+			Map(Delimited(Char('('), Lazy(func() Parser[int] { return depth }), Char(')')), func(inner int) int {
+				return inner + 1
+			}),
+			Value(0, Match("")),
+		},
+	)
+
+	return depth
+}
+
+func TestLazy(t *testing.T) {
+	tests := []ParserTestCase[string, int]{
+		{
+			name:  "successful parse",
+			input: "((()))rest",
+			want: ParseResult[int]{
+				next:   "rest",
+				parsed: 3,
+				err:    nil,
+			},
+		},
+		{
+			name:  "successful empty parse",
+			input: "rest",
+			want: ParseResult[int]{
+				next:   "rest",
+				parsed: 0,
+				err:    nil,
+			},
+		},
+	}
+
+	ExecParserTestCases(t, func(string) Parser[int] { return nestingDepth() }, tests)
+}
+
+func TestRule(t *testing.T) {
+	list := NewRule[[]int]()
+	item := Alt(ParsersList[int]{
+		Map(list.Parse, func(items []int) int { return len(items) }),
+		Value(0, Char('x')),
+	})
+
+	list.Define(Delimited(Char('['), SeparatedList0(item, Char(','), DENY_TRAILING), Char(']')))
+
+	tests := []ParserTestCase[string, []int]{
+		{
+			name:  "successful parse",
+			input: "[x,[x,x],[]]",
+			want: ParseResult[[]int]{
+				next:   "",
+				parsed: []int{0, 2, 0},
+				err:    nil,
+			},
+		},
+	}
+
+	ExecParserTestCases(t, func(string) Parser[[]int] { return list.Parse }, tests)
+}
+
+func TestUndefinedRule(t *testing.T) {
+	rule := NewRule[string]()
+	next, parsed, err := Alt(ParsersList[string]{rule.Parse, Char('a')})("abc")
+	want := &ParseError{Position: inputStart, Err: ErrUndefinedRule, Fatal: true}
+
+	if next != "" || parsed != "" || !reflect.DeepEqual(err, want) || !errors.Is(err, ErrUndefinedRule) {
+		t.Fatalf("expected %+v, but got %q, %q and %+v", want, next, parsed, err)
+	}
+}
+
+func TestRecursiveRule(t *testing.T) {
+	table := NewMemoTable()
+	expression := NewRecursiveRule[int](table)
+	number := Map(StrictTakeWhile(unicode.IsDigit), func(digits string) int {
+		n, _ := strconv.Atoi(digits)
+		return n
+	})
+
+	// expression := expression '-' number | number
+	expression.Define(Alt(ParsersList[int]{
+		Map(Tuple3(expression.Parse, Char('-'), number), func(parsed Tuple3Result[int, string, int]) int {
+			return parsed.First - parsed.Third
+		}),
+		number,
+	}))
+
+	tests := []ParserTestCase[string, int]{
+		{
+			name:  "successful parse",
+			input: "10-3-2;",
+			want: ParseResult[int]{
+				next:   ";",
+				parsed: 5,
+				err:    nil,
+			},
+		},
+		{
+			name:  "successful base case parse",
+			input: "10;",
+			want: ParseResult[int]{
+				next:   ";",
+				parsed: 10,
+				err:    nil,
+			},
+		},
+	}
+
+	ExecParserTestCases(t, func(string) Parser[int] { return Packrat(table, expression.Parse) }, tests)
+
+	t.Run("fail error", func(t *testing.T) {
+		_, _, err := Packrat(table, expression.Parse)("-1")
+		var parseErr *ParseError
+
+		if !errors.As(err, &parseErr) || parseErr.Expected != "character matching the predicate" || parseErr.Found != `'-'` {
+			t.Fatalf("expected the number error, but got %+v", err)
+		}
+	})
+}
+
+func TestRecursiveRuleParses(t *testing.T) {
+	table := NewMemoTable()
+	expression := NewRecursiveRule[int](table)
+
+	// The number is parsed by a nested Packrat, which must not discard the seed of the rule while it grows.
+	number := Packrat(table, Map(StrictTakeWhile(unicode.IsDigit), func(digits string) int {
+		n, _ := strconv.Atoi(digits)
+		return n
+	}))
+
+	// expression := expression '-' number | number
+	expression.Define(Alt(ParsersList[int]{
+		Map(Tuple3(expression.Parse, Char('-'), number), func(parsed Tuple3Result[int, string, int]) int {
+			return parsed.First - parsed.Third
+		}),
+		number,
+	}))
+
+	// Inputs of the same length, parsed without a Packrat around the rule.
+	tests := []ParserTestCase[string, int]{
+		{
+			name:  "first input",
+			input: "1-2-3",
+			want:  ParseResult[int]{next: "", parsed: -4, err: nil},
+		},
+		{
+			name:  "second input",
+			input: "9-2-3",
+			want:  ParseResult[int]{next: "", parsed: 4, err: nil},
+		},
+	}
+
+	ExecParserTestCases(t, func(string) Parser[int] { return expression.Parse }, tests)
+
+	if len(table.sessions) != 0 {
+		t.Fatalf("expected the cache to be discarded after parsing, but got %d caches", len(table.sessions))
+	}
+}
+
+func TestRecursiveRuleWithMemo(t *testing.T) {
+	table := NewMemoTable()
+	expression := NewRecursiveRule[string](table)
+	number := StrictTakeWhile(unicode.IsDigit)
+
+	// The memoized branch is cached while the seed grows, so its results must not outlive the seed they were parsed with.
+	expression.Define(Alt(ParsersList[string]{
+		Memo(table, Recognize(Tuple3(expression.Parse, Char('-'), number))),
+		number,
+	}))
+
+	tests := []ParserTestCase[string, string]{
+		{
+			name:  "successful parse",
+			input: "10-3-2;",
+			want:  ParseResult[string]{next: ";", parsed: "10-3-2", err: nil},
+		},
+	}
+
+	ExecParserTestCases(t, func(string) Parser[string] { return Packrat(table, expression.Parse) }, tests)
+}
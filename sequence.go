@@ -1,12 +1,9 @@
 package gom
 
-import (
-	"fmt"
-)
-
+// Represents the outputs of the two parsers of a [Pair].
 type PairResult[T, K any] struct {
-	first  T
-	second K
+	First  T
+	Second K
 }
 
 func Pair[T, K any](firstParser Parser[T], secondParser Parser[K]) Parser[PairResult[T, K]] {
@@ -15,17 +12,17 @@ func Pair[T, K any](firstParser Parser[T], secondParser Parser[K]) Parser[PairRe
 		rest, p1, err := firstParser(input)
 
 		if err != nil {
-			return "", result, fmt.Errorf("first parser failed")
+			return "", result, wrapError(err, "", "first parser of Pair")
 		}
 
 		next, p2, err := secondParser(rest)
 
 		if err != nil {
-			return "", result, fmt.Errorf("second parser failed")
+			return "", result, wrapError(err, consumedText(input, rest), "second parser of Pair")
 		}
 
-		result.first = p1
-		result.second = p2
+		result.First = p1
+		result.Second = p2
 
 		return next, result, nil
 	}
@@ -37,20 +34,20 @@ func Delimited[T, K, O any](opener Parser[T], parser Parser[O], closer Parser[K]
 
 		if err != nil {
 			var parsed O
-			return "", parsed, fmt.Errorf("opener parser failed")
+			return "", parsed, wrapError(err, "", "opener of Delimited")
 		}
 
-		next, parsed, err := parser(next)
+		rest, parsed, err := parser(next)
 
 		if err != nil {
-			return "", parsed, fmt.Errorf("content parser failed")
+			return "", parsed, wrapError(err, consumedText(input, next), "content of Delimited")
 		}
 
-		next, _, err = closer(next)
+		next, _, err = closer(rest)
 
 		if err != nil {
 			var parsed O
-			return "", parsed, fmt.Errorf("closer parser failed")
+			return "", parsed, wrapError(err, consumedText(input, rest), "closer of Delimited")
 		}
 
 		return next, parsed, nil
@@ -63,17 +60,17 @@ func Preceded[T, O any](preceded Parser[T], parser Parser[O]) Parser[O] {
 
 		if err != nil {
 			var parsed O
-			return "", parsed, fmt.Errorf("preceded parser failed")
+			return "", parsed, wrapError(err, "", "prefix of Preceded")
 		}
 
-		next, parsed, err := parser(next)
+		rest, parsed, err := parser(next)
 
 		if err != nil {
 			var parsed O
-			return "", parsed, fmt.Errorf("content parser failed")
+			return "", parsed, wrapError(err, consumedText(input, next), "content of Preceded")
 		}
 
-		return next, parsed, nil
+		return rest, parsed, nil
 	}
 }
 
@@ -82,16 +79,326 @@ func Terminated[T, O any](parser Parser[O], terminated Parser[T]) Parser[O] {
 		next, parsed, err := parser(input)
 
 		if err != nil {
-			return "", parsed, fmt.Errorf("content parser failed")
+			return "", parsed, wrapError(err, "", "content of Terminated")
 		}
 
-		next, _, err = terminated(next)
+		rest, _, err := terminated(next)
 
 		if err != nil {
 			var parsed O
-			return "", parsed, fmt.Errorf("terminated parser failed")
+			return "", parsed, wrapError(err, consumedText(input, next), "terminator of Terminated")
 		}
 
-		return next, parsed, nil
+		return rest, parsed, nil
+	}
+}
+
+// Takes a left parser, a separator parser and a right parser, and returns a parser which applies them in sequence, discarding the separator output.
+//
+// If any parser fails, returns empty values for the next string and the result, and returns its error wrapped with the failing parser.
+func SeparatedPair[T, S, K any](left Parser[T], separator Parser[S], right Parser[K]) Parser[PairResult[T, K]] {
+	return func(input string) (string, PairResult[T, K], error) {
+		var result PairResult[T, K]
+		var err error
+
+		next := input
+
+		if next, result.First, err = sequenceStep(input, next, left, "left parser of SeparatedPair"); err != nil {
+			return "", PairResult[T, K]{}, err
+		}
+
+		if next, _, err = sequenceStep(input, next, separator, "separator of SeparatedPair"); err != nil {
+			return "", PairResult[T, K]{}, err
+		}
+
+		if next, result.Second, err = sequenceStep(input, next, right, "right parser of SeparatedPair"); err != nil {
+			return "", PairResult[T, K]{}, err
+		}
+
+		return next, result, nil
+	}
+}
+
+// Runs a step of a sequence over the input left by its previous steps, wrapping its errors with the given context.
+func sequenceStep[O any](input, next string, parser Parser[O], context string) (string, O, error) {
+	rest, parsed, err := parser(next)
+
+	if err != nil {
+		return "", parsed, wrapError(err, consumedText(input, next), context)
+	}
+
+	return rest, parsed, nil
+}
+
+// Represents the outputs of the 3 parsers of a [Tuple3].
+type Tuple3Result[A, B, C any] struct {
+	First  A
+	Second B
+	Third  C
+}
+
+// Takes 3 parsers and returns a parser which applies them in sequence, collecting their outputs.
+//
+// If any parser fails, returns empty values for the next string and the result, and returns its error wrapped with the failing position in the sequence.
+func Tuple3[A, B, C any](first Parser[A], second Parser[B], third Parser[C]) Parser[Tuple3Result[A, B, C]] {
+	return func(input string) (string, Tuple3Result[A, B, C], error) {
+		var result Tuple3Result[A, B, C]
+		var err error
+
+		next := input
+
+		if next, result.First, err = sequenceStep(input, next, first, "first parser of Tuple3"); err != nil {
+			return "", Tuple3Result[A, B, C]{}, err
+		}
+
+		if next, result.Second, err = sequenceStep(input, next, second, "second parser of Tuple3"); err != nil {
+			return "", Tuple3Result[A, B, C]{}, err
+		}
+
+		if next, result.Third, err = sequenceStep(input, next, third, "third parser of Tuple3"); err != nil {
+			return "", Tuple3Result[A, B, C]{}, err
+		}
+
+		return next, result, nil
+	}
+}
+
+// Represents the outputs of the 4 parsers of a [Tuple4].
+type Tuple4Result[A, B, C, D any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+}
+
+// Takes 4 parsers and returns a parser which applies them in sequence, collecting their outputs.
+//
+// If any parser fails, returns empty values for the next string and the result, and returns its error wrapped with the failing position in the sequence.
+func Tuple4[A, B, C, D any](first Parser[A], second Parser[B], third Parser[C], fourth Parser[D]) Parser[Tuple4Result[A, B, C, D]] {
+	return func(input string) (string, Tuple4Result[A, B, C, D], error) {
+		var result Tuple4Result[A, B, C, D]
+		var err error
+
+		next := input
+
+		if next, result.First, err = sequenceStep(input, next, first, "first parser of Tuple4"); err != nil {
+			return "", Tuple4Result[A, B, C, D]{}, err
+		}
+
+		if next, result.Second, err = sequenceStep(input, next, second, "second parser of Tuple4"); err != nil {
+			return "", Tuple4Result[A, B, C, D]{}, err
+		}
+
+		if next, result.Third, err = sequenceStep(input, next, third, "third parser of Tuple4"); err != nil {
+			return "", Tuple4Result[A, B, C, D]{}, err
+		}
+
+		if next, result.Fourth, err = sequenceStep(input, next, fourth, "fourth parser of Tuple4"); err != nil {
+			return "", Tuple4Result[A, B, C, D]{}, err
+		}
+
+		return next, result, nil
+	}
+}
+
+// Represents the outputs of the 5 parsers of a [Tuple5].
+type Tuple5Result[A, B, C, D, E any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+	Fifth  E
+}
+
+// Takes 5 parsers and returns a parser which applies them in sequence, collecting their outputs.
+//
+// If any parser fails, returns empty values for the next string and the result, and returns its error wrapped with the failing position in the sequence.
+func Tuple5[A, B, C, D, E any](first Parser[A], second Parser[B], third Parser[C], fourth Parser[D], fifth Parser[E]) Parser[Tuple5Result[A, B, C, D, E]] {
+	return func(input string) (string, Tuple5Result[A, B, C, D, E], error) {
+		var result Tuple5Result[A, B, C, D, E]
+		var err error
+
+		next := input
+
+		if next, result.First, err = sequenceStep(input, next, first, "first parser of Tuple5"); err != nil {
+			return "", Tuple5Result[A, B, C, D, E]{}, err
+		}
+
+		if next, result.Second, err = sequenceStep(input, next, second, "second parser of Tuple5"); err != nil {
+			return "", Tuple5Result[A, B, C, D, E]{}, err
+		}
+
+		if next, result.Third, err = sequenceStep(input, next, third, "third parser of Tuple5"); err != nil {
+			return "", Tuple5Result[A, B, C, D, E]{}, err
+		}
+
+		if next, result.Fourth, err = sequenceStep(input, next, fourth, "fourth parser of Tuple5"); err != nil {
+			return "", Tuple5Result[A, B, C, D, E]{}, err
+		}
+
+		if next, result.Fifth, err = sequenceStep(input, next, fifth, "fifth parser of Tuple5"); err != nil {
+			return "", Tuple5Result[A, B, C, D, E]{}, err
+		}
+
+		return next, result, nil
+	}
+}
+
+// Represents the outputs of the 6 parsers of a [Tuple6].
+type Tuple6Result[A, B, C, D, E, F any] struct {
+	First  A
+	Second B
+	Third  C
+	Fourth D
+	Fifth  E
+	Sixth  F
+}
+
+// Takes 6 parsers and returns a parser which applies them in sequence, collecting their outputs.
+//
+// If any parser fails, returns empty values for the next string and the result, and returns its error wrapped with the failing position in the sequence.
+func Tuple6[A, B, C, D, E, F any](first Parser[A], second Parser[B], third Parser[C], fourth Parser[D], fifth Parser[E], sixth Parser[F]) Parser[Tuple6Result[A, B, C, D, E, F]] {
+	return func(input string) (string, Tuple6Result[A, B, C, D, E, F], error) {
+		var result Tuple6Result[A, B, C, D, E, F]
+		var err error
+
+		next := input
+
+		if next, result.First, err = sequenceStep(input, next, first, "first parser of Tuple6"); err != nil {
+			return "", Tuple6Result[A, B, C, D, E, F]{}, err
+		}
+
+		if next, result.Second, err = sequenceStep(input, next, second, "second parser of Tuple6"); err != nil {
+			return "", Tuple6Result[A, B, C, D, E, F]{}, err
+		}
+
+		if next, result.Third, err = sequenceStep(input, next, third, "third parser of Tuple6"); err != nil {
+			return "", Tuple6Result[A, B, C, D, E, F]{}, err
+		}
+
+		if next, result.Fourth, err = sequenceStep(input, next, fourth, "fourth parser of Tuple6"); err != nil {
+			return "", Tuple6Result[A, B, C, D, E, F]{}, err
+		}
+
+		if next, result.Fifth, err = sequenceStep(input, next, fifth, "fifth parser of Tuple6"); err != nil {
+			return "", Tuple6Result[A, B, C, D, E, F]{}, err
+		}
+
+		if next, result.Sixth, err = sequenceStep(input, next, sixth, "sixth parser of Tuple6"); err != nil {
+			return "", Tuple6Result[A, B, C, D, E, F]{}, err
+		}
+
+		return next, result, nil
+	}
+}
+
+// Represents the outputs of the 7 parsers of a [Tuple7].
+type Tuple7Result[A, B, C, D, E, F, G any] struct {
+	First   A
+	Second  B
+	Third   C
+	Fourth  D
+	Fifth   E
+	Sixth   F
+	Seventh G
+}
+
+// Takes 7 parsers and returns a parser which applies them in sequence, collecting their outputs.
+//
+// If any parser fails, returns empty values for the next string and the result, and returns its error wrapped with the failing position in the sequence.
+func Tuple7[A, B, C, D, E, F, G any](first Parser[A], second Parser[B], third Parser[C], fourth Parser[D], fifth Parser[E], sixth Parser[F], seventh Parser[G]) Parser[Tuple7Result[A, B, C, D, E, F, G]] {
+	return func(input string) (string, Tuple7Result[A, B, C, D, E, F, G], error) {
+		var result Tuple7Result[A, B, C, D, E, F, G]
+		var err error
+
+		next := input
+
+		if next, result.First, err = sequenceStep(input, next, first, "first parser of Tuple7"); err != nil {
+			return "", Tuple7Result[A, B, C, D, E, F, G]{}, err
+		}
+
+		if next, result.Second, err = sequenceStep(input, next, second, "second parser of Tuple7"); err != nil {
+			return "", Tuple7Result[A, B, C, D, E, F, G]{}, err
+		}
+
+		if next, result.Third, err = sequenceStep(input, next, third, "third parser of Tuple7"); err != nil {
+			return "", Tuple7Result[A, B, C, D, E, F, G]{}, err
+		}
+
+		if next, result.Fourth, err = sequenceStep(input, next, fourth, "fourth parser of Tuple7"); err != nil {
+			return "", Tuple7Result[A, B, C, D, E, F, G]{}, err
+		}
+
+		if next, result.Fifth, err = sequenceStep(input, next, fifth, "fifth parser of Tuple7"); err != nil {
+			return "", Tuple7Result[A, B, C, D, E, F, G]{}, err
+		}
+
+		if next, result.Sixth, err = sequenceStep(input, next, sixth, "sixth parser of Tuple7"); err != nil {
+			return "", Tuple7Result[A, B, C, D, E, F, G]{}, err
+		}
+
+		if next, result.Seventh, err = sequenceStep(input, next, seventh, "seventh parser of Tuple7"); err != nil {
+			return "", Tuple7Result[A, B, C, D, E, F, G]{}, err
+		}
+
+		return next, result, nil
+	}
+}
+
+// Represents the outputs of the 8 parsers of a [Tuple8].
+type Tuple8Result[A, B, C, D, E, F, G, H any] struct {
+	First   A
+	Second  B
+	Third   C
+	Fourth  D
+	Fifth   E
+	Sixth   F
+	Seventh G
+	Eighth  H
+}
+
+// Takes 8 parsers and returns a parser which applies them in sequence, collecting their outputs.
+//
+// If any parser fails, returns empty values for the next string and the result, and returns its error wrapped with the failing position in the sequence.
+func Tuple8[A, B, C, D, E, F, G, H any](first Parser[A], second Parser[B], third Parser[C], fourth Parser[D], fifth Parser[E], sixth Parser[F], seventh Parser[G], eighth Parser[H]) Parser[Tuple8Result[A, B, C, D, E, F, G, H]] {
+	return func(input string) (string, Tuple8Result[A, B, C, D, E, F, G, H], error) {
+		var result Tuple8Result[A, B, C, D, E, F, G, H]
+		var err error
+
+		next := input
+
+		if next, result.First, err = sequenceStep(input, next, first, "first parser of Tuple8"); err != nil {
+			return "", Tuple8Result[A, B, C, D, E, F, G, H]{}, err
+		}
+
+		if next, result.Second, err = sequenceStep(input, next, second, "second parser of Tuple8"); err != nil {
+			return "", Tuple8Result[A, B, C, D, E, F, G, H]{}, err
+		}
+
+		if next, result.Third, err = sequenceStep(input, next, third, "third parser of Tuple8"); err != nil {
+			return "", Tuple8Result[A, B, C, D, E, F, G, H]{}, err
+		}
+
+		if next, result.Fourth, err = sequenceStep(input, next, fourth, "fourth parser of Tuple8"); err != nil {
+			return "", Tuple8Result[A, B, C, D, E, F, G, H]{}, err
+		}
+
+		if next, result.Fifth, err = sequenceStep(input, next, fifth, "fifth parser of Tuple8"); err != nil {
+			return "", Tuple8Result[A, B, C, D, E, F, G, H]{}, err
+		}
+
+		if next, result.Sixth, err = sequenceStep(input, next, sixth, "sixth parser of Tuple8"); err != nil {
+			return "", Tuple8Result[A, B, C, D, E, F, G, H]{}, err
+		}
+
+		if next, result.Seventh, err = sequenceStep(input, next, seventh, "seventh parser of Tuple8"); err != nil {
+			return "", Tuple8Result[A, B, C, D, E, F, G, H]{}, err
+		}
+
+		if next, result.Eighth, err = sequenceStep(input, next, eighth, "eighth parser of Tuple8"); err != nil {
+			return "", Tuple8Result[A, B, C, D, E, F, G, H]{}, err
+		}
+
+		return next, result, nil
 	}
 }
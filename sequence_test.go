@@ -1,7 +1,6 @@
 package gom
 
 import (
-	"fmt"
 	"reflect"
 	"testing"
 	"unicode"
@@ -31,8 +30,8 @@ func TestPair(t *testing.T) {
 			want: ParseResult[PairResult[string, string]]{
 				next: "baz",
 				parsed: PairResult[string, string]{
-					first:  "foo",
-					second: "bar",
+					First:  "foo",
+					Second: "bar",
 				},
 				err: nil,
 			},
@@ -47,7 +46,17 @@ func TestPair(t *testing.T) {
 			want: ParseResult[PairResult[string, string]]{
 				next:   "",
 				parsed: PairResult[string, string]{},
-				err:    fmt.Errorf("first parser failed"),
+				err: &ParseError{
+					Position: Position{Offset: 0, Line: 1, Column: 1},
+					Expected: `'failed'`,
+					Found:    `'foobar'`,
+					Context:  []string{"first parser of Pair"},
+					Err: &ParseError{
+						Position: inputStart,
+						Expected: `'failed'`,
+						Found:    `'foobar'`,
+					},
+				},
 			},
 		},
 		{
@@ -60,7 +69,17 @@ func TestPair(t *testing.T) {
 			want: ParseResult[PairResult[string, string]]{
 				next:   "",
 				parsed: PairResult[string, string]{},
-				err:    fmt.Errorf("second parser failed"),
+				err: &ParseError{
+					Position: Position{Offset: 3, Line: 1, Column: 4},
+					Expected: `'failed'`,
+					Found:    `'barbaz'`,
+					Context:  []string{"second parser of Pair"},
+					Err: &ParseError{
+						Position: inputStart,
+						Expected: `'failed'`,
+						Found:    `'barbaz'`,
+					},
+				},
 			},
 		},
 	}
@@ -117,7 +136,17 @@ func TestDelimited(t *testing.T) {
 			want: ParseResult[string]{
 				next:   "",
 				parsed: "",
-				err:    fmt.Errorf("opener parser failed"),
+				err: &ParseError{
+					Position: Position{Offset: 0, Line: 1, Column: 1},
+					Expected: `'"'`,
+					Found:    `'H'`,
+					Context:  []string{"opener of Delimited"},
+					Err: &ParseError{
+						Position: inputStart,
+						Expected: `'"'`,
+						Found:    `'H'`,
+					},
+				},
 			},
 		},
 		{
@@ -131,7 +160,17 @@ func TestDelimited(t *testing.T) {
 			want: ParseResult[string]{
 				next:   "",
 				parsed: "",
-				err:    fmt.Errorf("content parser failed"),
+				err: &ParseError{
+					Position: Position{Offset: 1, Line: 1, Column: 2},
+					Expected: "character matching the predicate",
+					Found:    `'1'`,
+					Context:  []string{"content of Delimited"},
+					Err: &ParseError{
+						Position: inputStart,
+						Expected: "character matching the predicate",
+						Found:    `'1'`,
+					},
+				},
 			},
 		},
 		{
@@ -147,7 +186,17 @@ func TestDelimited(t *testing.T) {
 			want: ParseResult[string]{
 				next:   "",
 				parsed: "",
-				err:    fmt.Errorf("closer parser failed"),
+				err: &ParseError{
+					Position: Position{Offset: 6, Line: 1, Column: 7},
+					Expected: `']'`,
+					Found:    `'}'`,
+					Context:  []string{"closer of Delimited"},
+					Err: &ParseError{
+						Position: inputStart,
+						Expected: `']'`,
+						Found:    `'}'`,
+					},
+				},
 			},
 		},
 	}
@@ -201,7 +250,17 @@ func TestPreceded(t *testing.T) {
 			want: ParseResult[string]{
 				next:   "",
 				parsed: "",
-				err:    fmt.Errorf("preceded parser failed"),
+				err: &ParseError{
+					Position: Position{Offset: 0, Line: 1, Column: 1},
+					Expected: `'{'`,
+					Found:    `'<'`,
+					Context:  []string{"prefix of Preceded"},
+					Err: &ParseError{
+						Position: inputStart,
+						Expected: `'{'`,
+						Found:    `'<'`,
+					},
+				},
 			},
 		},
 		{
@@ -214,7 +273,17 @@ func TestPreceded(t *testing.T) {
 			want: ParseResult[string]{
 				next:   "",
 				parsed: "",
-				err:    fmt.Errorf("content parser failed"),
+				err: &ParseError{
+					Position: Position{Offset: 1, Line: 1, Column: 2},
+					Expected: "character matching the predicate",
+					Found:    `'P'`,
+					Context:  []string{"content of Preceded"},
+					Err: &ParseError{
+						Position: inputStart,
+						Expected: "character matching the predicate",
+						Found:    `'P'`,
+					},
+				},
 			},
 		},
 	}
@@ -268,7 +337,17 @@ func TestTerminated(t *testing.T) {
 			want: ParseResult[string]{
 				next:   "",
 				parsed: "",
-				err:    fmt.Errorf("content parser failed"),
+				err: &ParseError{
+					Position: Position{Offset: 0, Line: 1, Column: 1},
+					Expected: "character matching the predicate",
+					Found:    `'1'`,
+					Context:  []string{"content of Terminated"},
+					Err: &ParseError{
+						Position: inputStart,
+						Expected: "character matching the predicate",
+						Found:    `'1'`,
+					},
+				},
 			},
 		},
 		{
@@ -281,7 +360,17 @@ func TestTerminated(t *testing.T) {
 			want: ParseResult[string]{
 				next:   "",
 				parsed: "",
-				err:    fmt.Errorf("terminated parser failed"),
+				err: &ParseError{
+					Position: Position{Offset: 5, Line: 1, Column: 6},
+					Expected: "character matching the predicate",
+					Found:    `'<'`,
+					Context:  []string{"terminator of Terminated"},
+					Err: &ParseError{
+						Position: inputStart,
+						Expected: "character matching the predicate",
+						Found:    `'<'`,
+					},
+				},
 			},
 		},
 	}
@@ -297,3 +386,129 @@ func TestTerminated(t *testing.T) {
 		})
 	}
 }
+
+func TestTuple3(t *testing.T) {
+	tests := []ParserTestCase[string, Tuple3Result[string, string, string]]{
+		{
+			name:   "successful parse",
+			input:  "key=value;",
+			params: "=",
+			want: ParseResult[Tuple3Result[string, string, string]]{
+				next: ";",
+				parsed: Tuple3Result[string, string, string]{
+					First:  "key",
+					Second: "=",
+					Third:  "value",
+				},
+				err: nil,
+			},
+		},
+		{
+			name:   "third parser fail error",
+			input:  "key=;",
+			params: "=",
+			want: ParseResult[Tuple3Result[string, string, string]]{
+				next:   "",
+				parsed: Tuple3Result[string, string, string]{},
+				err: &ParseError{
+					Position: Position{Offset: 4, Line: 1, Column: 5},
+					Expected: "character matching the predicate",
+					Found:    `';'`,
+					Context:  []string{"third parser of Tuple3"},
+					Err: &ParseError{
+						Position: inputStart,
+						Expected: "character matching the predicate",
+						Found:    `';'`,
+					},
+				},
+			},
+		},
+	}
+
+	assignment := func(separator string) Parser[Tuple3Result[string, string, string]] {
+		return Tuple3(StrictTakeWhile(unicode.IsLetter), Match(separator), StrictTakeWhile(unicode.IsLetter))
+	}
+
+	ExecParserTestCases(t, assignment, tests)
+}
+
+func TestTuple8(t *testing.T) {
+	digit := OneOf("0123456789")
+	_, parsed, err := Tuple8(digit, digit, digit, digit, digit, digit, digit, digit)("12345678")
+	want := Tuple8Result[string, string, string, string, string, string, string, string]{
+		First:   "1",
+		Second:  "2",
+		Third:   "3",
+		Fourth:  "4",
+		Fifth:   "5",
+		Sixth:   "6",
+		Seventh: "7",
+		Eighth:  "8",
+	}
+
+	if err != nil || parsed != want {
+		t.Fatalf("expected %+v, but got %+v and %v", want, parsed, err)
+	}
+
+	_, _, err = Tuple8(digit, digit, digit, digit, digit, digit, digit, digit)("1234567")
+	wantErr := "expected one of '0123456789' but found end of input at line 1, column 8 in eighth parser of Tuple8"
+
+	if err == nil || err.Error() != wantErr {
+		t.Fatalf("expected %q, but got %v", wantErr, err)
+	}
+}
+
+func TestSeparatedPair(t *testing.T) {
+	tests := []ParserTestCase[string, PairResult[string, string]]{
+		{
+			name:   "successful parse",
+			input:  "key: value",
+			params: ": ",
+			want: ParseResult[PairResult[string, string]]{
+				next:   "",
+				parsed: PairResult[string, string]{First: "key", Second: "value"},
+				err:    nil,
+			},
+		},
+		{
+			name:   "separator fail error",
+			input:  "key=value",
+			params: ": ",
+			want: ParseResult[PairResult[string, string]]{
+				next:   "",
+				parsed: PairResult[string, string]{},
+				err: &ParseError{
+					Position: Position{Offset: 3, Line: 1, Column: 4},
+					Expected: `': '`,
+					Found:    `'=v'`,
+					Context:  []string{"separator of SeparatedPair"},
+					Err: &ParseError{
+						Position: inputStart,
+						Expected: `': '`,
+						Found:    `'=v'`,
+					},
+				},
+			},
+		},
+	}
+
+	entry := func(separator string) Parser[PairResult[string, string]] {
+		return SeparatedPair(StrictTakeWhile(unicode.IsLetter), Match(separator), StrictTakeWhile(unicode.IsLetter))
+	}
+
+	ExecParserTestCases(t, entry, tests)
+}
+
+func BenchmarkSequences(b *testing.B) {
+	word := StrictTakeWhile(unicode.IsLetter)
+
+	ExecParserBenchmarks(b, []ParserBenchmark{
+		NewParserBenchmark("Pair", "key=value", Pair(word, Char('='))),
+		NewParserBenchmark("Delimited", "(value)", Delimited(Char('('), word, Char(')'))),
+		NewParserBenchmark("Preceded", "=value", Preceded(Char('='), word)),
+		NewParserBenchmark("Terminated", "value;", Terminated(word, Char(';'))),
+		NewParserBenchmark("SeparatedPair", "key=value", SeparatedPair(word, Char('='), word)),
+		NewParserBenchmark("Tuple3", "key=value", Tuple3(word, Char('='), word)),
+		NewParserBenchmark("Tuple8", "a1b2c3d4", Tuple8(word, Take(1), word, Take(1), word, Take(1), word, Take(1))),
+	})
+}
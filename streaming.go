@@ -0,0 +1,224 @@
+package gom
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+)
+
+// Signals that a streaming parser reached the end of its input before it could decide whether the input matches.
+//
+// Needed holds the amount of extra bytes required to continue, or zero when it is unknown.
+type Incomplete struct {
+	Needed int
+}
+
+func (e *Incomplete) Error() string {
+	if e.Needed > 0 {
+		return fmt.Sprintf("incomplete input, need %d more bytes", e.Needed)
+	}
+
+	return "incomplete input"
+}
+
+// Reports whether the error signals that the parser needs more input to decide.
+func IsIncomplete(err error) bool {
+	var incomplete *Incomplete
+
+	return errors.As(err, &incomplete)
+}
+
+// Same parsing process than [Char] but in streaming mode: returns an [Incomplete] error when the input ends before a whole character.
+func StreamingChar(target rune) Parser[string] {
+	return func(input string) (string, string, error) {
+		if !utf8.FullRuneInString(input) {
+			return "", "", &Incomplete{Needed: 1}
+		}
+
+		return Char(target)(input)
+	}
+}
+
+// Same parsing process than [Match] but in streaming mode: returns an [Incomplete] error when the input is a truncated prefix of the target.
+func StreamingMatch(target string) Parser[string] {
+	return func(input string) (string, string, error) {
+		if len(input) < len(target) && strings.HasPrefix(target, input) {
+			return "", "", &Incomplete{Needed: len(target) - len(input)}
+		}
+
+		return Match(target)(input)
+	}
+}
+
+// Same parsing process than [Take] but in streaming mode: returns an [Incomplete] error when the input has less characters than required.
+func StreamingTake(amount uint) Parser[string] {
+	return func(input string) (string, string, error) {
+		end := 0
+
+		for i := uint(0); i < amount; i++ {
+			if !utf8.FullRuneInString(input[end:]) {
+				return "", "", &Incomplete{Needed: int(amount - i)}
+			}
+
+			_, size := utf8.DecodeRuneInString(input[end:])
+			end += size
+		}
+
+		return input[end:], input[:end], nil
+	}
+}
+
+// Same parsing process than [StrictTakeUntil] but in streaming mode: returns an [Incomplete] error when the target is not found in the input.
+func StreamingTakeUntil(target string) Parser[string] {
+	return func(input string) (string, string, error) {
+		index := strings.Index(input, target)
+
+		if index < 0 {
+			return "", "", &Incomplete{}
+		}
+
+		return input[index:], input[:index], nil
+	}
+}
+
+// Same parsing process than [TakeWhile] but in streaming mode: returns an [Incomplete] error when every character of the input complies the predicate.
+func StreamingTakeWhile(predicate Predicate) Parser[string] {
+	return func(input string) (string, string, error) {
+		parsed, _ := evalPredicate(input, FLEX, UNCOMPLY, predicate)
+
+		if len(parsed) == len(input) {
+			return "", "", &Incomplete{Needed: 1}
+		}
+
+		return input[len(parsed):], parsed, nil
+	}
+}
+
+// Same parsing process than [TakeTill] but in streaming mode: returns an [Incomplete] error when no character of the input complies the predicate.
+func StreamingTakeTill(predicate Predicate) Parser[string] {
+	return func(input string) (string, string, error) {
+		parsed, _ := evalPredicate(input, FLEX, COMPLY, predicate)
+
+		if len(parsed) == len(input) {
+			return "", "", &Incomplete{Needed: 1}
+		}
+
+		return input[len(parsed):], parsed, nil
+	}
+}
+
+// Minimum amount of bytes read from the reader each time a stream refills its buffer.
+const streamChunkSize = 4096
+
+// Maximum amount of consecutive reads which return no bytes and no error before a stream gives up on its reader.
+const maxEmptyReads = 100
+
+// Parses a sequence of items from a reader, refilling its buffer whenever the parser needs more input.
+//
+// Only the part of the input which has not been parsed yet is kept in memory, so arbitrarily large inputs can be
+// parsed item by item. Errors are located relative to the beginning of the whole stream.
+type Stream[O any] struct {
+	reader   io.Reader
+	parser   Parser[O]
+	buffer   string
+	position Position
+	eof      bool
+}
+
+// Takes a reader and the parser for a single item, and returns a stream which parses items from the reader.
+func NewStream[O any](reader io.Reader, parser Parser[O]) *Stream[O] {
+	return &Stream[O]{
+		reader:   reader,
+		parser:   parser,
+		position: inputStart,
+	}
+}
+
+// Parses the next item of the stream.
+//
+// When the parser returns an [Incomplete] error, more input is read and the parser runs again from the beginning of the item.
+// Successful parses are returned as they are, even if they consume the whole buffer, so the item parser must return
+// [Incomplete] whenever the end of the buffer could be the middle of an item. Returns [io.EOF] once the reader is
+// exhausted and every byte has been parsed, an error wrapping [ErrNoProgress] when the parser succeeds without consuming
+// anything, and [io.ErrNoProgress] when the reader keeps returning no bytes and no error.
+func (s *Stream[O]) Next() (O, error) {
+	var empty O
+
+	for {
+		if len(s.buffer) == 0 && s.eof {
+			return empty, io.EOF
+		}
+
+		if len(s.buffer) == 0 {
+			if err := s.fill(0); err != nil {
+				return empty, err
+			}
+
+			continue
+		}
+
+		next, parsed, err := s.parser(s.buffer)
+
+		var incomplete *Incomplete
+
+		if errors.As(err, &incomplete) {
+			if s.eof {
+				return empty, fmt.Errorf("%w: %w", io.ErrUnexpectedEOF, wrapErrorAt(err, s.position, ""))
+			}
+
+			if err := s.fill(incomplete.Needed); err != nil {
+				return empty, err
+			}
+
+			continue
+		}
+
+		if err != nil {
+			return empty, wrapErrorAt(err, s.position, "")
+		}
+
+		if len(next) == len(s.buffer) {
+			return empty, wrapErrorAt(ErrNoProgress, s.position, "")
+		}
+
+		s.position = s.position.advance(consumedText(s.buffer, next))
+		s.buffer = next
+
+		return parsed, nil
+	}
+}
+
+// Reads at least the needed amount of bytes from the reader into the buffer, unless the reader is exhausted before.
+func (s *Stream[O]) fill(needed int) error {
+	chunk := make([]byte, max(streamChunkSize, needed, len(s.buffer)))
+	read := 0
+	emptyReads := 0
+
+	for !s.eof && read < max(needed, 1) {
+		n, err := s.reader.Read(chunk[read:])
+		read += n
+
+		if errors.Is(err, io.EOF) {
+			s.eof = true
+		} else if err != nil {
+			return err
+		}
+
+		if n > 0 {
+			emptyReads = 0
+			continue
+		}
+
+		emptyReads++
+
+		if emptyReads == maxEmptyReads {
+			return io.ErrNoProgress
+		}
+	}
+
+	s.buffer += string(chunk[:read])
+
+	return nil
+}
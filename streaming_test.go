@@ -0,0 +1,376 @@
+package gom
+
+import (
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+	"unicode"
+)
+
+func TestStreamingMatch(t *testing.T) {
+	tests := []ParserTestCase[string, string]{
+		{
+			name:   "successful parse",
+			input:  "HTTP/1.1 200",
+			params: "HTTP/1.1",
+			want: ParseResult[string]{
+				next:   " 200",
+				parsed: "HTTP/1.1",
+				err:    nil,
+			},
+		},
+		{
+			name:   "incomplete input",
+			input:  "HTT",
+			params: "HTTP/1.1",
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "",
+				err:    &Incomplete{Needed: 5},
+			},
+		},
+		{
+			name:   "params does not match error",
+			input:  "FTP",
+			params: "HTTP/1.1",
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "",
+				err: &ParseError{
+					Position: inputStart,
+					Expected: `'HTTP/1.1'`,
+					Found:    `'FTP'`,
+				},
+			},
+		},
+	}
+
+	ExecParserTestCases(t, StreamingMatch, tests)
+}
+
+func TestStreamingChar(t *testing.T) {
+	tests := []ParserTestCase[rune, string]{
+		{
+			name:   "successful parse",
+			input:  "éa",
+			params: 'é',
+			want: ParseResult[string]{
+				next:   "a",
+				parsed: "é",
+				err:    nil,
+			},
+		},
+		{
+			name:   "truncated character",
+			input:  "é"[:1],
+			params: 'é',
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "",
+				err:    &Incomplete{Needed: 1},
+			},
+		},
+	}
+
+	ExecParserTestCases(t, StreamingChar, tests)
+}
+
+func TestStreamingTake(t *testing.T) {
+	tests := []ParserTestCase[uint, string]{
+		{
+			name:   "successful parse",
+			input:  "abcdef",
+			params: 4,
+			want: ParseResult[string]{
+				next:   "ef",
+				parsed: "abcd",
+				err:    nil,
+			},
+		},
+		{
+			name:   "incomplete input",
+			input:  "ab",
+			params: 4,
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "",
+				err:    &Incomplete{Needed: 2},
+			},
+		},
+	}
+
+	ExecParserTestCases(t, StreamingTake, tests)
+}
+
+func TestStreamingTakeUntil(t *testing.T) {
+	tests := []ParserTestCase[string, string]{
+		{
+			name:   "successful parse",
+			input:  "line\nrest",
+			params: "\n",
+			want: ParseResult[string]{
+				next:   "\nrest",
+				parsed: "line",
+				err:    nil,
+			},
+		},
+		{
+			name:   "incomplete input",
+			input:  "partial line",
+			params: "\n",
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "",
+				err:    &Incomplete{},
+			},
+		},
+	}
+
+	ExecParserTestCases(t, StreamingTakeUntil, tests)
+}
+
+func TestStreamingTakeWhile(t *testing.T) {
+	tests := []ParserTestCase[Predicate, string]{
+		{
+			name:   "successful parse",
+			input:  "123abc",
+			params: unicode.IsDigit,
+			want: ParseResult[string]{
+				next:   "abc",
+				parsed: "123",
+				err:    nil,
+			},
+		},
+		{
+			name:   "incomplete input",
+			input:  "123",
+			params: unicode.IsDigit,
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "",
+				err:    &Incomplete{Needed: 1},
+			},
+		},
+	}
+
+	ExecParserTestCases(t, StreamingTakeWhile, tests)
+}
+
+func TestStreamingTakeTill(t *testing.T) {
+	tests := []ParserTestCase[Predicate, string]{
+		{
+			name:   "successful parse",
+			input:  "abc 123",
+			params: unicode.IsSpace,
+			want: ParseResult[string]{
+				next:   " 123",
+				parsed: "abc",
+				err:    nil,
+			},
+		},
+		{
+			name:   "incomplete input",
+			input:  "abc",
+			params: unicode.IsSpace,
+			want: ParseResult[string]{
+				next:   "",
+				parsed: "",
+				err:    &Incomplete{Needed: 1},
+			},
+		},
+	}
+
+	ExecParserTestCases(t, StreamingTakeTill, tests)
+}
+
+func TestIncompletePropagation(t *testing.T) {
+	tests := []struct {
+		name   string
+		parser Parser[[]string]
+		input  string
+	}{
+		{
+			name:   "Alt does not try other branches",
+			parser: Count(Alt(ParsersList[string]{StreamingMatch("GET"), Match("G")}), 1),
+			input:  "G",
+		},
+		{
+			name:   "Many does not stop at the end of the buffer",
+			parser: Many(Terminated(StreamingTakeUntil(";"), Char(';'))),
+			input:  "a;b;c",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, _, err := tc.parser(tc.input)
+
+			if !IsIncomplete(err) {
+				t.Fatalf("%s: expected an incomplete error, but got %v", tc.name, err)
+			}
+		})
+	}
+}
+
+func TestStream(t *testing.T) {
+	line := Terminated(StreamingTakeUntil("\n"), Char('\n'))
+	largeLine := strings.Repeat("x", 3*streamChunkSize)
+
+	tests := []struct {
+		name   string
+		reader io.Reader
+		want   []string
+		err    error
+	}{
+		{
+			name:   "successful parse",
+			reader: strings.NewReader("first\nsecond\nthird\n"),
+			want:   []string{"first", "second", "third"},
+			err:    io.EOF,
+		},
+		{
+			name:   "successful parse byte by byte",
+			reader: iotest.OneByteReader(strings.NewReader("first\nsecond\n")),
+			want:   []string{"first", "second"},
+			err:    io.EOF,
+		},
+		{
+			name:   "items larger than a chunk",
+			reader: strings.NewReader("a\n" + largeLine + "\n"),
+			want:   []string{"a", largeLine},
+			err:    io.EOF,
+		},
+		{
+			name:   "unexpected end of input",
+			reader: strings.NewReader("first\nsecond"),
+			want:   []string{"first"},
+			err:    io.ErrUnexpectedEOF,
+		},
+		{
+			name:   "reader error",
+			reader: iotest.ErrReader(iotest.ErrTimeout),
+			want:   nil,
+			err:    iotest.ErrTimeout,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stream := NewStream(tc.reader, line)
+
+			var got []string
+			var err error
+
+			for {
+				var item string
+
+				if item, err = stream.Next(); err != nil {
+					break
+				}
+
+				got = append(got, item)
+			}
+
+			if !reflect.DeepEqual(got, tc.want) || !errors.Is(err, tc.err) {
+				t.Fatalf("%s: expected %q and %v, but got %q and %v", tc.name, tc.want, tc.err, got, err)
+			}
+		})
+	}
+}
+
+func TestStreamWithoutEOF(t *testing.T) {
+	reader, writer := io.Pipe()
+	defer writer.Close()
+
+	stream := NewStream(reader, Terminated(StreamingTakeUntil("\n"), Char('\n')))
+	items := make(chan string)
+
+	go func() {
+		item, _ := stream.Next()
+		items <- item
+	}()
+
+	// The writer is left open, so the complete item must be returned without waiting for more input.
+	if _, err := writer.Write([]byte("ab\n")); err != nil {
+		t.Fatalf("unexpected write error %v", err)
+	}
+
+	select {
+	case item := <-items:
+		if item != "ab" {
+			t.Fatalf("expected %q, but got %q", "ab", item)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("expected the item before the end of the stream")
+	}
+}
+
+func TestStreamErrorPosition(t *testing.T) {
+	record := Preceded(StreamingTakeWhile(unicode.IsSpace), Terminated(StreamingTakeWhile(unicode.IsLetter), Char(';')))
+	stream := NewStream(iotest.HalfReader(strings.NewReader("abc;\nde;\nfg!")), record)
+
+	for i := 0; i < 2; i++ {
+		if _, err := stream.Next(); err != nil {
+			t.Fatalf("expected a successful parse, but got %v", err)
+		}
+	}
+
+	_, err := stream.Next()
+
+	var parseErr *ParseError
+
+	if !errors.As(err, &parseErr) {
+		t.Fatalf("expected a parse error, but got %v", err)
+	}
+
+	want := Position{Offset: 11, Line: 3, Column: 3}
+
+	if parseErr.Position != want {
+		t.Fatalf("expected error at %+v, but got %+v", want, parseErr.Position)
+	}
+}
+
+// Reader which never returns bytes nor errors.
+type emptyReader struct{}
+
+func (emptyReader) Read([]byte) (int, error) {
+	return 0, nil
+}
+
+func TestStreamNoProgress(t *testing.T) {
+	tests := []struct {
+		name   string
+		reader io.Reader
+		parser Parser[string]
+		err    error
+	}{
+		{
+			name:   "parser without progress",
+			reader: strings.NewReader("abc"),
+			parser: TakeWhile(unicode.IsDigit),
+			err:    ErrNoProgress,
+		},
+		{
+			name:   "reader without progress",
+			reader: emptyReader{},
+			parser: Char('a'),
+			err:    io.ErrNoProgress,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stream := NewStream(tc.reader, tc.parser)
+
+			for i := 0; i < 2; i++ {
+				if _, err := stream.Next(); !errors.Is(err, tc.err) {
+					t.Fatalf("%s: expected %v, but got %v", tc.name, tc.err, err)
+				}
+			}
+		})
+	}
+}
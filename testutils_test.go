@@ -33,3 +33,82 @@ func ExecParserTestCases[P, O any](t *testing.T, parser ParserWrapper[P, O], tes
 
 	}
 }
+
+type InputParseResult[I, O any] struct {
+	next   I
+	parsed O
+	err    error
+}
+
+type InputParserTestCase[I, P, O any] struct {
+	name   string
+	input  I
+	params P
+	want   InputParseResult[I, O]
+}
+
+type InputParserWrapper[I, P, O any] func(param P) InputParser[I, O]
+
+func ExecInputParserTestCases[I, P, O any](t *testing.T, parser InputParserWrapper[I, P, O], tests []InputParserTestCase[I, P, O]) {
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			next, parsed, err := parser(tc.params)(tc.input)
+			got := InputParseResult[I, O]{next, parsed, err}
+
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Fatalf("%s: expected %+v, but got %+v", tc.name, tc.want, got)
+			}
+		})
+	}
+}
+
+type ParserBenchmark struct {
+	name   string
+	input  string
+	fails  bool
+	parser func(input string) (string, error)
+}
+
+func NewParserBenchmark[O any](name string, input string, parser Parser[O]) ParserBenchmark {
+	return ParserBenchmark{
+		name:  name,
+		input: input,
+		parser: func(input string) (string, error) {
+			next, _, err := parser(input)
+			return next, err
+		},
+	}
+}
+
+// Same as [NewParserBenchmark] but for a parser which is expected to fail over the input.
+func NewFailingParserBenchmark[O any](name string, input string, parser Parser[O]) ParserBenchmark {
+	benchmark := NewParserBenchmark(name, input, parser)
+	benchmark.fails = true
+
+	return benchmark
+}
+
+func ExecParserBenchmarks(b *testing.B, benchmarks []ParserBenchmark) {
+	for _, bc := range benchmarks {
+		b.Run(bc.name, func(b *testing.B) {
+			next, err := bc.parser(bc.input)
+
+			if (err != nil) != bc.fails {
+				b.Fatalf("%s: unexpected error %v", bc.name, err)
+			}
+
+			// Throughput is measured over the consumed input only, so failing parsers report none.
+			if !bc.fails {
+				b.SetBytes(int64(len(bc.input) - len(next)))
+			}
+			b.ReportAllocs()
+			b.ResetTimer()
+
+			for i := 0; i < b.N; i++ {
+				if _, err := bc.parser(bc.input); (err != nil) != bc.fails {
+					b.Fatalf("%s: unexpected error %v", bc.name, err)
+				}
+			}
+		})
+	}
+}
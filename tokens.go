@@ -0,0 +1,218 @@
+package gom
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Formats a token for error reporting, quoting it when it is a character of a rune slice.
+func formatToken[T any](token T) string {
+	if ch, ok := any(token).(rune); ok {
+		return quote(string(ch))
+	}
+
+	return fmt.Sprintf("%v", token)
+}
+
+// Describes the tokens of a token slice for error reporting.
+func describeToken[T any](tokens []T) string {
+	if len(tokens) == 0 {
+		return endOfInput
+	}
+
+	return formatToken(tokens[0])
+}
+
+// Builds a parse error located at the given token index of a token slice.
+func newTokenError(offset int, expected, found string) *ParseError {
+	err := newParseError(expected, found)
+	err.Position = Position{Offset: offset, Line: 1, Column: offset + 1}
+
+	return err
+}
+
+// Takes a target token and returns a parser which matches the first token of the input against the target.
+//
+// If it matches, returns the rest of the tokens, the matched token and a nil error.
+// Else returns empty values for the next tokens and matched token, and returns a fullfilled error.
+//
+// Token parsers report positions as token indexes: Offset counts tokens and Column is the 1-based token index.
+func Token[S ~[]T, T comparable](target T) InputParser[S, T] {
+	return func(input S) (S, T, error) {
+		var parsed T
+
+		if len(input) == 0 || input[0] != target {
+			return nil, parsed, newTokenError(0, formatToken(target), describeToken(input))
+		}
+
+		return input[1:], input[0], nil
+	}
+}
+
+// Takes a target sequence of tokens and returns a parser which matches the first tokens of the input against the target.
+//
+// If it matches, returns the rest of the tokens, the matched tokens and a nil error.
+// Else returns empty values for the next tokens and matched tokens, and returns a fullfilled error.
+func TokenMatch[S ~[]T, T comparable](target S) InputParser[S, S] {
+	return func(input S) (S, S, error) {
+		if len(target) > len(input) || !slices.Equal(input[:len(target)], target) {
+			mismatch := 0
+
+			for mismatch < len(input) && mismatch < len(target) && input[mismatch] == target[mismatch] {
+				mismatch++
+			}
+
+			return nil, nil, newTokenError(mismatch, formatToken(target[mismatch]), describeToken(input[mismatch:]))
+		}
+
+		return input[len(target):], input[:len(target)], nil
+	}
+}
+
+// Takes an unsigned integer and returns a parser which takes the first n tokens of the input.
+//
+// If there are enough tokens, returns the rest of the tokens, the taken tokens and a nil error.
+// Else returns empty values for the next tokens and taken tokens, and returns a fullfilled error.
+func TokenTake[S ~[]T, T any](amount uint) InputParser[S, S] {
+	return func(input S) (S, S, error) {
+		if amount > uint(len(input)) {
+			return nil, nil, newTokenError(len(input), fmt.Sprintf("%d tokens", amount), endOfInput)
+		}
+
+		return input[amount:], input[:amount], nil
+	}
+}
+
+// Takes a set of tokens and returns a parser which tries to match the first token of the input against one of the given tokens.
+//
+// If it matches, returns the rest of the tokens, the matched token and a nil error.
+// Else returns empty values for the next tokens and matched token, and returns a fullfilled error.
+func TokenOneOf[S ~[]T, T comparable](tokens S) InputParser[S, T] {
+	return func(input S) (S, T, error) {
+		var parsed T
+
+		if len(input) == 0 || !slices.Contains(tokens, input[0]) {
+			return nil, parsed, newTokenError(0, fmt.Sprintf("one of %v", tokens), describeToken(input))
+		}
+
+		return input[1:], input[0], nil
+	}
+}
+
+// Takes a set of tokens and returns a parser which tries to match the first token of the input against none of the given tokens.
+//
+// If it doesnt match, returns the rest of the tokens, the first token and a nil error.
+// Else returns empty values for the next tokens and first token, and returns a fullfilled error.
+func TokenNoneOf[S ~[]T, T comparable](tokens S) InputParser[S, T] {
+	return func(input S) (S, T, error) {
+		var parsed T
+
+		if len(input) == 0 || slices.Contains(tokens, input[0]) {
+			return nil, parsed, newTokenError(0, fmt.Sprintf("none of %v", tokens), describeToken(input))
+		}
+
+		return input[1:], input[0], nil
+	}
+}
+
+// Returns the index of the first occurrence of the target tokens in the input, or -1 if they are not present.
+func indexTokens[S ~[]T, T comparable](input, target S) int {
+	for i := 0; i+len(target) <= len(input); i++ {
+		if slices.Equal(input[i:i+len(target)], target) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Same parsing process than [TakeUntil] but over token slices.
+func TokenTakeUntil[S ~[]T, T comparable](target S) InputParser[S, S] {
+	return func(input S) (S, S, error) {
+		index := indexTokens(input, target)
+
+		if index < 0 {
+			return input, input[:0], nil
+		}
+
+		return input[index:], input[:index], nil
+	}
+}
+
+// Same parsing process than [StrictTakeUntil] but over token slices.
+func TokenStrictTakeUntil[S ~[]T, T comparable](target S) InputParser[S, S] {
+	return func(input S) (S, S, error) {
+		index := indexTokens(input, target)
+
+		if index < 0 {
+			return nil, nil, newTokenError(len(input), formatToken(target), endOfInput)
+		}
+
+		return input[index:], input[:index], nil
+	}
+}
+
+// Helper function for define the predicate evaluation over token slices, based on the parser mode and compliance condition.
+func evalTokenPredicate[S ~[]T, T any](input S, parserMode ParserMode, breakOn ComplianceMode, predicate func(token T) bool) (S, error) {
+	end := 0
+
+	for end < len(input) && predicate(input[end]) == (breakOn == UNCOMPLY) {
+		end++
+	}
+
+	if parserMode == STRICT && end == 0 {
+		expected := "token matching the predicate"
+
+		if breakOn == COMPLY {
+			expected = "token not matching the predicate"
+		}
+
+		return nil, newTokenError(0, expected, describeToken(input))
+	}
+
+	return input[:end], nil
+}
+
+// Same parsing process than [TakeWhile] but over token slices.
+func TokenTakeWhile[S ~[]T, T any](predicate func(token T) bool) InputParser[S, S] {
+	return func(input S) (S, S, error) {
+		parsed, _ := evalTokenPredicate(input, FLEX, UNCOMPLY, predicate)
+
+		return input[len(parsed):], parsed, nil
+	}
+}
+
+// Same parsing process than [StrictTakeWhile] but over token slices.
+func TokenStrictTakeWhile[S ~[]T, T any](predicate func(token T) bool) InputParser[S, S] {
+	return func(input S) (S, S, error) {
+		parsed, err := evalTokenPredicate(input, STRICT, UNCOMPLY, predicate)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return input[len(parsed):], parsed, nil
+	}
+}
+
+// Same parsing process than [TakeTill] but over token slices.
+func TokenTakeTill[S ~[]T, T any](predicate func(token T) bool) InputParser[S, S] {
+	return func(input S) (S, S, error) {
+		parsed, _ := evalTokenPredicate(input, FLEX, COMPLY, predicate)
+
+		return input[len(parsed):], parsed, nil
+	}
+}
+
+// Same parsing process than [StrictTakeTill] but over token slices.
+func TokenStrictTakeTill[S ~[]T, T any](predicate func(token T) bool) InputParser[S, S] {
+	return func(input S) (S, S, error) {
+		parsed, err := evalTokenPredicate(input, STRICT, COMPLY, predicate)
+
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return input[len(parsed):], parsed, nil
+	}
+}
@@ -0,0 +1,260 @@
+package gom
+
+import "testing"
+
+type testToken struct {
+	kind  string
+	value string
+}
+
+type testTokens []testToken
+
+var (
+	letToken    = testToken{kind: "keyword", value: "let"}
+	nameToken   = testToken{kind: "identifier", value: "x"}
+	equalsToken = testToken{kind: "operator", value: "="}
+	numberToken = testToken{kind: "number", value: "1"}
+	semiToken   = testToken{kind: "punctuation", value: ";"}
+)
+
+func TestToken(t *testing.T) {
+	tests := []InputParserTestCase[testTokens, testToken, testToken]{
+		{
+			name:   "successful parse",
+			input:  testTokens{letToken, nameToken},
+			params: letToken,
+			want: InputParseResult[testTokens, testToken]{
+				next:   testTokens{nameToken},
+				parsed: letToken,
+				err:    nil,
+			},
+		},
+		{
+			name:   "token does not match error",
+			input:  testTokens{nameToken},
+			params: letToken,
+			want: InputParseResult[testTokens, testToken]{
+				next:   nil,
+				parsed: testToken{},
+				err: &ParseError{
+					Position: inputStart,
+					Expected: "{keyword let}",
+					Found:    "{identifier x}",
+				},
+			},
+		},
+		{
+			name:   "empty input error",
+			input:  testTokens{},
+			params: letToken,
+			want: InputParseResult[testTokens, testToken]{
+				next:   nil,
+				parsed: testToken{},
+				err: &ParseError{
+					Position: inputStart,
+					Expected: "{keyword let}",
+					Found:    "end of input",
+				},
+			},
+		},
+	}
+
+	ExecInputParserTestCases(t, Token[testTokens], tests)
+}
+
+func TestTokenMatch(t *testing.T) {
+	tests := []InputParserTestCase[testTokens, testTokens, testTokens]{
+		{
+			name:   "successful parse",
+			input:  testTokens{letToken, nameToken, equalsToken, numberToken},
+			params: testTokens{letToken, nameToken, equalsToken},
+			want: InputParseResult[testTokens, testTokens]{
+				next:   testTokens{numberToken},
+				parsed: testTokens{letToken, nameToken, equalsToken},
+				err:    nil,
+			},
+		},
+		{
+			name:   "params does not match error",
+			input:  testTokens{letToken, nameToken, numberToken},
+			params: testTokens{letToken, nameToken, equalsToken},
+			want: InputParseResult[testTokens, testTokens]{
+				next:   nil,
+				parsed: nil,
+				err: &ParseError{
+					Position: Position{Offset: 2, Line: 1, Column: 3},
+					Expected: "{operator =}",
+					Found:    "{number 1}",
+				},
+			},
+		},
+	}
+
+	ExecInputParserTestCases(t, TokenMatch[testTokens], tests)
+}
+
+func TestTokenTake(t *testing.T) {
+	tests := []InputParserTestCase[testTokens, uint, testTokens]{
+		{
+			name:   "successful parse",
+			input:  testTokens{letToken, nameToken, semiToken},
+			params: 2,
+			want: InputParseResult[testTokens, testTokens]{
+				next:   testTokens{semiToken},
+				parsed: testTokens{letToken, nameToken},
+				err:    nil,
+			},
+		},
+		{
+			name:   "too short input error",
+			input:  testTokens{letToken},
+			params: 2,
+			want: InputParseResult[testTokens, testTokens]{
+				next:   nil,
+				parsed: nil,
+				err: &ParseError{
+					Position: Position{Offset: 1, Line: 1, Column: 2},
+					Expected: "2 tokens",
+					Found:    "end of input",
+				},
+			},
+		},
+	}
+
+	ExecInputParserTestCases(t, TokenTake[testTokens], tests)
+}
+
+func TestTokenOneOf(t *testing.T) {
+	tests := []InputParserTestCase[testTokens, testTokens, testToken]{
+		{
+			name:   "successful parse",
+			input:  testTokens{numberToken, semiToken},
+			params: testTokens{nameToken, numberToken},
+			want: InputParseResult[testTokens, testToken]{
+				next:   testTokens{semiToken},
+				parsed: numberToken,
+				err:    nil,
+			},
+		},
+	}
+
+	ExecInputParserTestCases(t, TokenOneOf[testTokens], tests)
+}
+
+func TestTokenNoneOf(t *testing.T) {
+	tests := []InputParserTestCase[testTokens, testTokens, testToken]{
+		{
+			name:   "some token match error",
+			input:  testTokens{semiToken},
+			params: testTokens{semiToken},
+			want: InputParseResult[testTokens, testToken]{
+				next:   nil,
+				parsed: testToken{},
+				err: &ParseError{
+					Position: inputStart,
+					Expected: "none of [{punctuation ;}]",
+					Found:    "{punctuation ;}",
+				},
+			},
+		},
+	}
+
+	ExecInputParserTestCases(t, TokenNoneOf[testTokens], tests)
+}
+
+func TestTokenTakeUntil(t *testing.T) {
+	tests := []InputParserTestCase[testTokens, testTokens, testTokens]{
+		{
+			name:   "successful parse",
+			input:  testTokens{letToken, nameToken, semiToken, letToken},
+			params: testTokens{semiToken},
+			want: InputParseResult[testTokens, testTokens]{
+				next:   testTokens{semiToken, letToken},
+				parsed: testTokens{letToken, nameToken},
+				err:    nil,
+			},
+		},
+		{
+			name:   "params dont match",
+			input:  testTokens{letToken, nameToken},
+			params: testTokens{semiToken},
+			want: InputParseResult[testTokens, testTokens]{
+				next:   testTokens{letToken, nameToken},
+				parsed: testTokens{},
+				err:    nil,
+			},
+		},
+	}
+
+	ExecInputParserTestCases(t, TokenTakeUntil[testTokens], tests)
+}
+
+func TestTokenStrictTakeWhile(t *testing.T) {
+	isValue := func(token testToken) bool {
+		return token.kind == "identifier" || token.kind == "number"
+	}
+
+	tests := []InputParserTestCase[testTokens, func(token testToken) bool, testTokens]{
+		{
+			name:   "successful parse",
+			input:  testTokens{nameToken, numberToken, semiToken},
+			params: isValue,
+			want: InputParseResult[testTokens, testTokens]{
+				next:   testTokens{semiToken},
+				parsed: testTokens{nameToken, numberToken},
+				err:    nil,
+			},
+		},
+		{
+			name:   "predicate dont match error",
+			input:  testTokens{semiToken},
+			params: isValue,
+			want: InputParseResult[testTokens, testTokens]{
+				next:   nil,
+				parsed: nil,
+				err: &ParseError{
+					Position: inputStart,
+					Expected: "token matching the predicate",
+					Found:    "{punctuation ;}",
+				},
+			},
+		},
+	}
+
+	ExecInputParserTestCases(t, TokenStrictTakeWhile[testTokens], tests)
+}
+
+func TestTokenStrictTakeTill(t *testing.T) {
+	isSemi := func(token testToken) bool {
+		return token == semiToken
+	}
+
+	tests := []InputParserTestCase[testTokens, func(token testToken) bool, testTokens]{
+		{
+			name:   "successful parse",
+			input:  testTokens{letToken, nameToken, semiToken},
+			params: isSemi,
+			want: InputParseResult[testTokens, testTokens]{
+				next:   testTokens{semiToken},
+				parsed: testTokens{letToken, nameToken},
+				err:    nil,
+			},
+		},
+		{
+			name:   "predicate dont match error",
+			input:  testTokens{semiToken},
+			params: isSemi,
+			want: InputParseResult[testTokens, testTokens]{
+				next:   nil,
+				parsed: nil,
+				err: &ParseError{
+					Position: inputStart,
+					Expected: "token not matching the predicate",
+					Found:    "{punctuation ;}",
+				},
+			},
+		},
+	}
+
+	ExecInputParserTestCases(t, TokenStrictTakeTill[testTokens], tests)
+}